@@ -72,6 +72,7 @@ var (
 			treasuryclient.TransferFromDistributionProfitsToBuyBackLiquidityProposalHandler,
 			treasuryclient.TransferFromTreasuryToSwapEscrowProposalHandler,
 			treasuryclient.TransferFromSwapEscrowToBuyBackProposalHandler,
+			treasuryclient.SetPriceCurveProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -93,6 +94,7 @@ var (
 		treasury.DistributionProfitsModuleName:   {supply.Burner},
 		treasury.TreasuryEscrowModuleName:        nil,
 		treasury.SwapEscrowModuleName:            nil,
+		treasury.OrderBookModuleName:             nil,
 		staking.BondedPoolName:                   {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName:                {supply.Burner, supply.Staking},
 		gov.ModuleName:                           nil,
@@ -104,6 +106,7 @@ var (
 		distribution.SecurityTokenFundModuleName: nil,
 		distribution.SavingsModuleName:           nil,
 		distribution.SavingsDistributionModuleName: nil,
+		hra.AuctionEscrowModuleName:              nil,
 	}
 )
 
@@ -352,6 +355,7 @@ func NewAnathaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		app.supplyKeeper,
 		&stakingKeeper,
 		&hraKeeper,
+		app.ModuleAccountAddrs(),
 	)
 
 	app.hraKeeper = *hraKeeper.SetHooks(
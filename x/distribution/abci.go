@@ -31,8 +31,18 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 	// Distribution from NVRP to Savers
 	k.DistributeFromNvrp(ctx)
 
+	k.TrackRewardRates(ctx)
+
+	// Weight the savings of expired lockups at 1x
+	k.ExpireSavingsLockups(ctx)
+
+	// Fold rewards of auto compounding savings into their principal
+	k.CompoundAutoSavings(ctx)
+
 	// Distribution from NVRP to Validator Rewards
 	if ctx.BlockHeight() > 1 {
 		k.AllocateTokens(ctx, req.LastCommitInfo.GetVotes())
 	}
+
+	k.TrackNvrpCarryover(ctx)
 }
\ No newline at end of file
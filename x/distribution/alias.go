@@ -35,6 +35,10 @@ var (
 	NewSecurityTokenFundDistributionProposal = types.NewSecurityTokenFundDistributionProposal
 
 	NewMsgWithdrawNameReward                 = types.NewMsgWithdrawNameReward
+	NewMsgTopUpSavings                       = types.NewMsgTopUpSavings
+	NewMsgWithdrawSavingsPartially           = types.NewMsgWithdrawSavingsPartially
+	NewMsgSetSavingsAutoCompound             = types.NewMsgSetSavingsAutoCompound
+	NewMsgSetWithdrawAddress                 = types.NewMsgSetWithdrawAddress
 	ModuleCdc                                = types.ModuleCdc
 	RegisterCodec                            = types.RegisterCodec
 )
@@ -52,4 +56,16 @@ type (
 	MsgDepositSavings                     = types.MsgDepositSavings
 	MsgWithdrawSavings                    = types.MsgWithdrawSavings
 	MsgWithdrawSavingsInterest            = types.MsgWithdrawSavingsInterest
+	MsgTopUpSavings                       = types.MsgTopUpSavings
+	SavingsLockupTerm                     = types.SavingsLockupTerm
+	SavingsLockup                         = types.SavingsLockup
+	SavingsLockupRecord                   = types.SavingsLockupRecord
+	MsgSetSavingsAutoCompound             = types.MsgSetSavingsAutoCompound
+	MsgSetWithdrawAddress                 = types.MsgSetWithdrawAddress
+	WithdrawAddressRecord                 = types.WithdrawAddressRecord
+	QueryResSavingsApy                    = types.QueryResSavingsApy
+	QueryResAnalytics                     = types.QueryResAnalytics
+	QueryResSavingsProjection             = types.QueryResSavingsProjection
+	QuerySavingsProjectionParams          = types.QuerySavingsProjectionParams
+	MsgWithdrawSavingsPartially           = types.MsgWithdrawSavingsPartially
 )
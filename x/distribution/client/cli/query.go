@@ -7,8 +7,11 @@ import (
 	"github.com/DFWallet/anatha/client/flags"
 	"github.com/DFWallet/anatha/codec"
 	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/config"
+	denom "github.com/DFWallet/project-anatha/utils"
 	"github.com/DFWallet/project-anatha/x/distribution/internal/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
@@ -26,6 +29,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdNameReward(queryRoute, cdc),
 			GetCmdSavingsReward(queryRoute, cdc),
 			GetCmdSavings(queryRoute, cdc),
+			GetCmdSavingsApy(queryRoute, cdc),
+			GetCmdAnalytics(queryRoute, cdc),
+			GetCmdSavingsProjection(queryRoute, cdc),
 			GetCmdValidatorReward(queryRoute, cdc),
 		)...,
 	)
@@ -139,4 +145,79 @@ func GetCmdValidatorReward(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return cliCtx.PrintOutput(out)
 		},
 	}
-}
\ No newline at end of file
+}
+func GetCmdSavingsApy(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "savings-apy",
+		Short: "Query the projected savings APR and APY for every lockup term",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/savings-apy", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResSavingsApy
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdAnalytics(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "analytics",
+		Short: "Query the NVRP inflow, its split between savers and validators and the current reward rates",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/analytics", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResAnalytics
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdSavingsProjection(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "savings-projection [amount]",
+		Short: "Estimate the yearly reward of a savings deposit before making it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := denom.ParseAndConvertCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQuerySavingsProjectionParams(amount.AmountOf(config.DefaultDenom), viper.GetDuration(FlagLockup))
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/savings-projection", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResSavingsProjection
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Duration(FlagLockup, 0, "lockup term of the deposit, e.g. 720h")
+
+	return cmd
+}
@@ -13,6 +13,12 @@ import (
 	denom "github.com/DFWallet/project-anatha/utils"
 	"github.com/DFWallet/project-anatha/x/distribution/internal/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"strconv"
+)
+
+const (
+	FlagLockup = "lockup"
 )
 
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
@@ -30,6 +36,10 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdDepositSavings(cdc),
 		GetCmdWithdrawSavings(cdc),
 		GetCmdWithdrawSavingsInterest(cdc),
+		GetCmdTopUpSavings(cdc),
+		GetCmdWithdrawSavingsPartially(cdc),
+		GetCmdSetSavingsAutoCompound(cdc),
+		GetCmdSetWithdrawAddress(cdc),
 	)...)
 
 	return distributionTxCmd
@@ -80,9 +90,9 @@ func GetCmdWithdrawValidatorReward(cdc *codec.Codec) *cobra.Command {
 }
 
 func GetCmdDepositSavings(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "deposit-savings [amount]",
-		Short: "Deposit savings",
+		Short: "Deposit savings, optionally locked for one of the lockup terms set in the parameters",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -95,7 +105,7 @@ func GetCmdDepositSavings(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgDepositSavings(cliCtx.GetFromAddress(), amount)
+			msg := types.NewMsgDepositSavings(cliCtx.GetFromAddress(), amount, viper.GetDuration(FlagLockup))
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -104,6 +114,10 @@ func GetCmdDepositSavings(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().Duration(FlagLockup, 0, "lockup term of the deposit, e.g. 720h")
+
+	return cmd
 }
 
 func GetCmdWithdrawSavings(cdc *codec.Codec) *cobra.Command {
@@ -149,3 +163,111 @@ func GetCmdWithdrawSavingsInterest(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetCmdTopUpSavings(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "top-up-savings [amount]",
+		Short: "Add to an existing savings deposit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := denom.ParseAndConvertCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTopUpSavings(cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdWithdrawSavingsPartially(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-savings-partially [amount]",
+		Short: "Withdraw part of the savings deposit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := denom.ParseAndConvertCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawSavingsPartially(cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdSetSavingsAutoCompound(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-savings-auto-compound [true|false]",
+		Short: "Enable or disable folding savings interest into the savings deposit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSavingsAutoCompound(cliCtx.GetFromAddress(), enabled)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdSetWithdrawAddress(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-withdraw-address [withdraw-address]",
+		Short: "Set the address receiving name rewards, savings interest and validator rewards",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			withdrawAddress, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetWithdrawAddress(cliCtx.GetFromAddress(), withdrawAddress)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
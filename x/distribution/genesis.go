@@ -57,11 +57,44 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 		keeper.SetSavingsRewardLeftover(ctx, srl.Address, srl.Amount)
 	}
 
+	// genesis files exported before lockups existed only carry the unweighted stake
+	if data.SavingsWeightedStake.IsNil() {
+		keeper.SetSavingsWeightedStake(ctx, data.SavingsStake)
+	} else {
+		keeper.SetSavingsWeightedStake(ctx, data.SavingsWeightedStake)
+	}
+
+	for _, sl := range data.SavingsLockups {
+		keeper.SetSavingsLockup(ctx, sl.Address, sl.Lockup)
+	}
+
+	for _, address := range data.SavingsAutoCompound {
+		keeper.SetSavingsAutoCompound(ctx, address)
+	}
+
 	for _, rew := range data.ValidatorAccumulatedRewards {
 		keeper.SetValidatorAccumulatedRewards(ctx, rew.ValidatorAddress, rew.Accumulated)
 	}
 
 	keeper.SetNvrpRemainder(ctx, data.NvrpRemainder)
+
+	for _, wa := range data.WithdrawAddresses {
+		keeper.SetWithdrawAddress(ctx, wa.Address, wa.WithdrawAddress)
+	}
+
+	if ! data.NvrpInflow.IsNil() {
+		keeper.SetNvrpInflow(ctx, data.NvrpInflow)
+	}
+
+	if ! data.NvrpCarryover.IsNil() {
+		keeper.SetNvrpCarryover(ctx, data.NvrpCarryover)
+	}
+
+	// the last checkpoint is the latest, the one before it the previous
+	checkpointKeys := [][]byte{types.RewardRateCheckpointLatestKey, types.RewardRateCheckpointPreviousKey}
+	for i, checkpoint := range data.RewardRateCheckpoints {
+		keeper.SetRewardRateCheckpoint(ctx, checkpointKeys[len(data.RewardRateCheckpoints) - 1 - i], checkpoint)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
@@ -149,6 +182,38 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		return false
 	})
 
+	savingsLockups := make([]types.SavingsLockupRecord, 0)
+	keeper.IterateSavingsLockups(ctx, func(address sdk.AccAddress, lockup types.SavingsLockup) (stop bool) {
+		savingsLockups = append(savingsLockups, types.SavingsLockupRecord{
+			Address: address,
+			Lockup:  lockup,
+		})
+		return false
+	})
+
+	savingsAutoCompound := make([]sdk.AccAddress, 0)
+	keeper.IterateSavingsAutoCompound(ctx, func(address sdk.AccAddress) (stop bool) {
+		savingsAutoCompound = append(savingsAutoCompound, address)
+		return false
+	})
+
+	withdrawAddresses := make([]types.WithdrawAddressRecord, 0)
+	keeper.IterateWithdrawAddresses(ctx, func(address sdk.AccAddress, withdrawAddress sdk.AccAddress) (stop bool) {
+		withdrawAddresses = append(withdrawAddresses, types.WithdrawAddressRecord{
+			Address:         address,
+			WithdrawAddress: withdrawAddress,
+		})
+		return false
+	})
+
+	rewardRateCheckpoints := make([]types.RewardRateCheckpoint, 0)
+	for _, key := range [][]byte{types.RewardRateCheckpointPreviousKey, types.RewardRateCheckpointLatestKey} {
+		checkpoint, found := keeper.GetRewardRateCheckpoint(ctx, key)
+		if found {
+			rewardRateCheckpoints = append(rewardRateCheckpoints, checkpoint)
+		}
+	}
+
 	return NewGenesisState(
 		params,
 		keeper.GetNameStake(ctx),
@@ -164,7 +229,14 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		addressSavingsStake,
 		savingsRewardEscrow,
 		savingsRewardLeftover,
+		keeper.GetSavingsWeightedStake(ctx),
+		savingsLockups,
+		savingsAutoCompound,
 		validatorRewards,
 		keeper.GetNvrpRemainder(ctx),
+		withdrawAddresses,
+		keeper.GetNvrpInflow(ctx),
+		keeper.GetNvrpCarryover(ctx),
+		rewardRateCheckpoints,
 	)
 }
@@ -29,6 +29,18 @@ func NewHandler(k Keeper) sdk.Handler {
 			case MsgWithdrawSavingsInterest:
 				return handleMsgWithdrawSavingsInterest(ctx, k, msg)
 
+			case MsgTopUpSavings:
+				return handleMsgTopUpSavings(ctx, k, msg)
+
+			case MsgWithdrawSavingsPartially:
+				return handleMsgWithdrawSavingsPartially(ctx, k, msg)
+
+			case MsgSetSavingsAutoCompound:
+				return handleMsgSetSavingsAutoCompound(ctx, k, msg)
+
+			case MsgSetWithdrawAddress:
+				return handleMsgSetWithdrawAddress(ctx, k, msg)
+
 			default:
 				errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName,  msg)
 				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -70,7 +82,7 @@ func handleMsgDepositSavings(ctx sdk.Context, k Keeper, msg MsgDepositSavings) (
 		return nil, hra.ErrNameNotRegistered
 	}
 
-	err := k.HandleDepositSavings(ctx, msg.Sender, msg.Amount)
+	err := k.HandleDepositSavings(ctx, msg.Sender, msg.Amount, msg.Lockup)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +118,62 @@ func handleMsgWithdrawSavingsInterest(ctx sdk.Context, k Keeper, msg MsgWithdraw
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgTopUpSavings(ctx sdk.Context, k Keeper, msg MsgTopUpSavings) (*sdk.Result, error) {
+	// check if sender has a HRA
+	if ctx.BlockHeight() > hra.NameConstraintBlock && ! k.HraKeeper.OwnsAnyName(ctx, msg.Sender) {
+		return nil, hra.ErrNameNotRegistered
+	}
+
+	err := k.HandleTopUpSavings(ctx, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgWithdrawSavingsPartially(ctx sdk.Context, k Keeper, msg MsgWithdrawSavingsPartially) (*sdk.Result, error) {
+	// check if sender has a HRA
+	if ctx.BlockHeight() > hra.NameConstraintBlock && ! k.HraKeeper.OwnsAnyName(ctx, msg.Sender) {
+		return nil, hra.ErrNameNotRegistered
+	}
+
+	err := k.HandleWithdrawSavingsPartially(ctx, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetSavingsAutoCompound(ctx sdk.Context, k Keeper, msg MsgSetSavingsAutoCompound) (*sdk.Result, error) {
+	// check if sender has a HRA
+	if ctx.BlockHeight() > hra.NameConstraintBlock && ! k.HraKeeper.OwnsAnyName(ctx, msg.Sender) {
+		return nil, hra.ErrNameNotRegistered
+	}
+
+	err := k.HandleSetSavingsAutoCompound(ctx, msg.Sender, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetWithdrawAddress(ctx sdk.Context, k Keeper, msg MsgSetWithdrawAddress) (*sdk.Result, error) {
+	// check if sender has a HRA
+	if ctx.BlockHeight() > hra.NameConstraintBlock && ! k.HraKeeper.OwnsAnyName(ctx, msg.Sender) {
+		return nil, hra.ErrNameNotRegistered
+	}
+
+	err := k.HandleSetWithdrawAddress(ctx, msg.Sender, msg.WithdrawAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func NewDistributionProposalHandler(k Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
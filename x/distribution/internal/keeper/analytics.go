@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	sdk "github.com/DFWallet/anatha/types"
+	sdkerrors "github.com/DFWallet/anatha/types/errors"
+	"github.com/DFWallet/project-anatha/config"
+	"github.com/DFWallet/project-anatha/x/distribution/internal/types"
+)
+
+// TrackRewardRates checkpoints the reward accumulators once per RewardRateWindow,
+// the previous checkpoint is kept so the projections always cover at least one full window
+func (k Keeper) TrackRewardRates(ctx sdk.Context) {
+	latest, found := k.GetRewardRateCheckpoint(ctx, types.RewardRateCheckpointLatestKey)
+	if found && ctx.BlockTime().Sub(latest.Time) < types.RewardRateWindow {
+		return
+	}
+
+	if found {
+		k.SetRewardRateCheckpoint(ctx, types.RewardRateCheckpointPreviousKey, latest)
+	}
+
+	k.SetRewardRateCheckpoint(ctx, types.RewardRateCheckpointLatestKey, k.currentRewardRateCheckpoint(ctx))
+}
+
+// TrackNvrpCarryover records the NVRP balance left after the allocation so the next block only counts new inflow
+func (k Keeper) TrackNvrpCarryover(ctx sdk.Context) {
+	k.SetNvrpCarryover(ctx, k.supplyKeeper.GetModuleAccount(ctx, types.NvrpModuleName).GetCoins().AmountOf(config.DefaultDenom))
+}
+
+// rewardRateWindow returns the oldest checkpoint together with the current state
+func (k Keeper) rewardRateWindow(ctx sdk.Context) (types.RewardRateCheckpoint, types.RewardRateCheckpoint) {
+	now := k.currentRewardRateCheckpoint(ctx)
+
+	from, found := k.GetRewardRateCheckpoint(ctx, types.RewardRateCheckpointPreviousKey)
+	if ! found {
+		from, found = k.GetRewardRateCheckpoint(ctx, types.RewardRateCheckpointLatestKey)
+	}
+	if ! found {
+		from = now
+	}
+
+	return from, now
+}
+
+func (k Keeper) currentRewardRateCheckpoint(ctx sdk.Context) types.RewardRateCheckpoint {
+	return types.NewRewardRateCheckpoint(
+		k.GetSavingsRewardRate(ctx),
+		k.GetNameRewardRate(ctx),
+		k.GetNvrpInflow(ctx),
+		ctx.BlockTime(),
+	)
+}
+
+// savingsShare is the part of the NVRP inflow going to savers, computed the same way as in DistributeFromNvrp
+func (k Keeper) savingsShare(ctx sdk.Context, inflow sdk.Dec, saved sdk.Dec) sdk.Dec {
+	bonded := k.stakingKeeper.TotalBondedTokens(ctx).ToDec()
+
+	if saved.Add(bonded).IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	return inflow.Mul(
+		saved.QuoTruncate(
+			saved.Add(bonded),
+		),
+	).MulTruncate(k.SavingsSplitAdjustment(ctx))
+}
+
+// ProjectSavingsApy annualizes the savings reward rate growth since the oldest checkpoint,
+// the base rate applies to unlocked savings and is scaled by the multiplier of every lockup term
+func (k Keeper) ProjectSavingsApy(ctx sdk.Context) types.QueryResSavingsApy {
+	from, now := k.rewardRateWindow(ctx)
+
+	apr := types.Annualize(from.SavingsRewardRate, now.SavingsRewardRate, now.Time.Sub(from.Time))
+
+	terms := make([]types.SavingsTermApy, 0)
+	for _, term := range k.SavingsLockupTerms(ctx) {
+		termApr := apr.Mul(term.Multiplier)
+
+		terms = append(terms, types.SavingsTermApy{
+			Duration:   term.Duration,
+			Multiplier: term.Multiplier,
+			Apr:        termApr,
+			Apy:        types.ProjectSavingsApy(termApr),
+		})
+	}
+
+	return types.QueryResSavingsApy{
+		Since: from.Time,
+		Apr:   apr,
+		Apy:   types.ProjectSavingsApy(apr),
+		Terms: terms,
+	}
+}
+
+func (k Keeper) GetAnalytics(ctx sdk.Context) types.QueryResAnalytics {
+	from, now := k.rewardRateWindow(ctx)
+	elapsed := now.Time.Sub(from.Time)
+
+	savingsShare := k.savingsShare(ctx, sdk.OneDec(), k.GetSavingsStake(ctx).ToDec())
+
+	return types.QueryResAnalytics{
+		Since: from.Time,
+
+		NvrpInflowPerYear: types.Annualize(from.NvrpInflow.ToDec(), now.NvrpInflow.ToDec(), elapsed),
+		SavingsStake:      k.GetSavingsStake(ctx),
+		BondedTokens:      k.stakingKeeper.TotalBondedTokens(ctx),
+		SavingsShare:      savingsShare,
+		ValidatorShare:    sdk.OneDec().Sub(savingsShare),
+		SavingsApr:        types.Annualize(from.SavingsRewardRate, now.SavingsRewardRate, elapsed),
+
+		NameStake:               k.GetNameStake(ctx),
+		NameRewardPerYear:       types.Annualize(from.NameRewardRate, now.NameRewardRate, elapsed),
+		PendingNameDistribution: k.GetPendingNameDistribution(ctx),
+	}
+}
+
+// ProjectSavings estimates the yearly reward of a new deposit at the current NVRP inflow,
+// including the dilution the deposit itself causes to the savings pool
+func (k Keeper) ProjectSavings(ctx sdk.Context, amount sdk.Int, multiplier sdk.Dec) types.QueryResSavingsProjection {
+	from, now := k.rewardRateWindow(ctx)
+
+	inflow := types.Annualize(from.NvrpInflow.ToDec(), now.NvrpInflow.ToDec(), now.Time.Sub(from.Time))
+
+	saved := k.GetSavingsStake(ctx).Add(amount).ToDec()
+	savingsInflow := k.savingsShare(ctx, inflow, saved)
+
+	weightedAmount := amount.ToDec().MulTruncate(multiplier)
+	weighted := k.GetSavingsWeightedStake(ctx).ToDec().Add(weightedAmount)
+
+	rewardPerYear := sdk.ZeroDec()
+	if weighted.IsPositive() {
+		rewardPerYear = savingsInflow.Mul(weightedAmount).QuoTruncate(weighted)
+	}
+
+	apr := sdk.ZeroDec()
+	if amount.IsPositive() {
+		apr = rewardPerYear.QuoInt(amount)
+	}
+
+	return types.QueryResSavingsProjection{
+		Amount:        amount,
+		Multiplier:    multiplier,
+		SavingsShare:  k.savingsShare(ctx, sdk.OneDec(), saved),
+		RewardPerYear: rewardPerYear,
+		Apr:           apr,
+	}
+}
+
+func (k Keeper) GetSavingsProjection(ctx sdk.Context, params types.QuerySavingsProjectionParams) (types.QueryResSavingsProjection, error) {
+	if params.Amount.IsNil() || ! params.Amount.IsPositive() {
+		return types.QueryResSavingsProjection{}, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	multiplier := sdk.OneDec()
+	if params.Lockup != 0 {
+		term, found := k.GetSavingsLockupTerm(ctx, params.Lockup)
+		if ! found {
+			return types.QueryResSavingsProjection{}, sdkerrors.Wrap(types.ErrInvalidSavingsLockup, params.Lockup.String())
+		}
+		multiplier = term.Multiplier
+	}
+
+	projection := k.ProjectSavings(ctx, params.Amount, multiplier)
+	projection.Lockup = params.Lockup
+
+	return projection, nil
+}
+
+// Storage
+
+func (k Keeper) GetNvrpInflow(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetNvrpInflowKey())
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var inflow sdk.Int
+	k.cdc.MustUnmarshalBinaryBare(bz, &inflow)
+
+	return inflow
+}
+
+func (k Keeper) SetNvrpInflow(ctx sdk.Context, inflow sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetNvrpInflowKey(), k.cdc.MustMarshalBinaryBare(inflow))
+}
+
+func (k Keeper) GetNvrpCarryover(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetNvrpCarryoverKey())
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var carryover sdk.Int
+	k.cdc.MustUnmarshalBinaryBare(bz, &carryover)
+
+	return carryover
+}
+
+func (k Keeper) SetNvrpCarryover(ctx sdk.Context, carryover sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetNvrpCarryoverKey(), k.cdc.MustMarshalBinaryBare(carryover))
+}
+
+func (k Keeper) GetRewardRateCheckpoint(ctx sdk.Context, key []byte) (types.RewardRateCheckpoint, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return types.RewardRateCheckpoint{}, false
+	}
+
+	var checkpoint types.RewardRateCheckpoint
+	k.cdc.MustUnmarshalBinaryBare(bz, &checkpoint)
+
+	return checkpoint, true
+}
+
+func (k Keeper) SetRewardRateCheckpoint(ctx sdk.Context, key []byte, checkpoint types.RewardRateCheckpoint) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(checkpoint))
+}
@@ -77,6 +77,12 @@ func (k Keeper) DistributeFromNvrp(ctx sdk.Context){
 	nvrpBalanceInt := k.supplyKeeper.GetModuleAccount(ctx, types.NvrpModuleName).GetCoins().AmountOf(config.DefaultDenom)
 	nvrpBalanceDec := nvrpBalanceInt.ToDec()
 
+	// the balance left over by the previous block was already counted
+	inflow := nvrpBalanceInt.Sub(k.GetNvrpCarryover(ctx))
+	if inflow.IsPositive() {
+		k.SetNvrpInflow(ctx, k.GetNvrpInflow(ctx).Add(inflow))
+	}
+
 	bonded := k.stakingKeeper.TotalBondedTokens(ctx).ToDec()
 	saved := k.GetSavingsStake(ctx).ToDec()
 
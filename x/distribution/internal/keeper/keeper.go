@@ -19,9 +19,11 @@ type Keeper struct {
 	supplyKeeper supply.Keeper
 	stakingKeeper *staking.Keeper
 	HraKeeper    *hra.Keeper
+
+	blacklistedAddrs map[string]bool
 }
 
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, supplyKeeper supply.Keeper, stakingKeeper *staking.Keeper, hraKeeper *hra.Keeper) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, supplyKeeper supply.Keeper, stakingKeeper *staking.Keeper, hraKeeper *hra.Keeper, blacklistedAddrs map[string]bool) Keeper {
 	AccountMustBePresent(&supplyKeeper, types.AmcModuleName)
 	AccountMustBePresent(&supplyKeeper, types.NvrpModuleName)
 	AccountMustBePresent(&supplyKeeper, types.HRAHolderRewardModuleName)
@@ -35,6 +37,7 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, s
 		supplyKeeper: supplyKeeper,
 		stakingKeeper: stakingKeeper,
 		HraKeeper:    hraKeeper,
+		blacklistedAddrs: blacklistedAddrs,
 	}
 }
 
@@ -47,4 +47,14 @@ func (k Keeper) DevelopmentFundShare(ctx sdk.Context) (res sdk.Dec) {
 func (k Keeper) SecurityTokenFundShare(ctx sdk.Context) (res sdk.Dec) {
 	k.paramSpace.Get(ctx, types.KeySecurityTokenFundShare, &res)
 	return
-}
\ No newline at end of file
+}
+
+func (k Keeper) SavingsLockupTerms(ctx sdk.Context) (res []types.SavingsLockupTerm) {
+	k.paramSpace.Get(ctx, types.KeySavingsLockupTerms, &res)
+	return
+}
+
+func (k Keeper) SavingsEarlyWithdrawalPenalty(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeySavingsEarlyWithdrawalPenalty, &res)
+	return
+}
@@ -15,6 +15,9 @@ const (
 	QueryValidatorReward = "validator-reward"
 	QuerySavingsReward = "savings-reward"
 	QuerySavings = "savings"
+	QuerySavingsApy = "savings-apy"
+	QueryAnalytics = "analytics"
+	QuerySavingsProjection = "savings-projection"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -30,6 +33,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 				return querySavingsReward(ctx,path[1:], req, k)
 			case QuerySavings:
 				return querySavings(ctx, path[1:], req, k)
+			case QuerySavingsApy:
+				return querySavingsApy(ctx, k)
+			case QueryAnalytics:
+				return queryAnalytics(ctx, k)
+			case QuerySavingsProjection:
+				return querySavingsProjection(ctx, req, k)
 
 			default:
 				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown distribution query endpoint")
@@ -104,6 +113,49 @@ func querySavings(ctx sdk.Context, path []string, req abci.RequestQuery, k Keepe
 	return res, nil
 }
 
+func querySavingsApy(ctx sdk.Context, k Keeper) ([]byte, error) {
+	apy := k.ProjectSavingsApy(ctx)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, apy)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAnalytics(ctx sdk.Context, k Keeper) ([]byte, error) {
+	analytics := k.GetAnalytics(ctx)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, analytics)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func querySavingsProjection(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySavingsProjectionParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	projection, err := k.GetSavingsProjection(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, projection)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryValidatorReward(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	address, err := sdk.ValAddressFromBech32(path[0])
 	if err != nil {
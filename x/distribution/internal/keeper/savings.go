@@ -2,17 +2,33 @@ package keeper
 
 import (
 	sdk "github.com/DFWallet/anatha/types"
+	sdkerrors "github.com/DFWallet/anatha/types/errors"
 	"github.com/DFWallet/project-anatha/config"
 	"github.com/DFWallet/project-anatha/x/distribution/internal/types"
+	"time"
 )
 
 // Handler
 
-func (k Keeper) HandleDepositSavings(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins) error {
+func (k Keeper) HandleDepositSavings(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins, lockup time.Duration) error {
 	if k.HasSavings(ctx, sender) {
 		return types.ErrAlreadyHasSavings
 	}
 
+	unlockTime := ctx.BlockTime()
+
+	if lockup > 0 {
+		term, found := k.GetSavingsLockupTerm(ctx, lockup)
+		if ! found {
+			return sdkerrors.Wrap(types.ErrInvalidSavingsLockup, lockup.String())
+		}
+
+		savingsLockup := types.NewSavingsLockup(term, ctx.BlockTime())
+		k.SetSavingsLockup(ctx, sender, savingsLockup)
+
+		unlockTime = savingsLockup.UnlockTime
+	}
+
 	err := k.depositSavings(ctx, sender, amount, true)
 	if err != nil {
 		return err
@@ -22,6 +38,8 @@ func (k Keeper) HandleDepositSavings(ctx sdk.Context, sender sdk.AccAddress, amo
 		sdk.NewEvent(
 			types.EventTypeDepositSavings,
 			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyLockup, lockup.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockTime, unlockTime.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -38,11 +56,23 @@ func (k Keeper) HandleWithdrawSavings(ctx sdk.Context, sender sdk.AccAddress) er
 		return types.ErrHasNoSavings
 	}
 
-	reward, err := k.withdrawSavingsReward(ctx, sender, true)
+	err := k.expireSavingsLockup(ctx, sender)
+	if err != nil {
+		return err
+	}
+
+	stake, _ := k.GetSavingsStakeByAddress(ctx, sender)
+
+	reward, _ := k.withdrawSavingsReward(ctx, sender, false) // Error can be ignored due to supply keeper not being invoked
+
+	penalty, err := k.refundSavings(ctx, sender, stake)
 	if err != nil {
 		return err
 	}
 
+	k.DeleteSavingsLockup(ctx, sender)
+	k.DeleteSavingsAutoCompound(ctx, sender)
+
 	if ! reward.IsZero() {
 		err := k.DistributeSavingsReward(ctx, sender, reward)
 		if err != nil {
@@ -54,6 +84,7 @@ func (k Keeper) HandleWithdrawSavings(ctx sdk.Context, sender sdk.AccAddress) er
 		sdk.NewEvent(
 			types.EventTypeWithdrawSavings,
 			sdk.NewAttribute(types.AttributeKeyReward, k.GetSavingsRewardEscrow(ctx, sender).Add(reward...).String()),
+			sdk.NewAttribute(types.AttributeKeyPenalty, penalty.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -70,11 +101,16 @@ func (k Keeper) HandleWithdrawSavingsInterest(ctx sdk.Context, sender sdk.AccAdd
 		return types.ErrRewardWithdrawalDisabled
 	}
 
+	err := k.expireSavingsLockup(ctx, sender)
+	if err != nil {
+		return err
+	}
+
 	amount, receivingRewards := k.GetSavingsStakeByAddress(ctx, sender)
 
 	reward, _ := k.withdrawSavingsReward(ctx, sender, false) // Error can be ignored due to supply keeper not being invoked
 
-	err := k.DistributeSavingsReward(ctx, sender, reward)
+	err = k.DistributeSavingsReward(ctx, sender, reward)
 	if err != nil {
 		return err
 	}
@@ -104,6 +140,110 @@ func (k Keeper) HandleWithdrawSavingsInterest(ctx sdk.Context, sender sdk.AccAdd
 	return nil
 }
 
+func (k Keeper) HandleTopUpSavings(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins) error {
+	if ! k.HasSavings(ctx, sender) {
+		return types.ErrHasNoSavings
+	}
+
+	err := k.expireSavingsLockup(ctx, sender)
+	if err != nil {
+		return err
+	}
+
+	stake, reward, err := k.settleSavingsReward(ctx, sender)
+	if err != nil {
+		return err
+	}
+
+	err = k.ClaimSavingsStake(ctx, sender, amount)
+	if err != nil {
+		return err
+	}
+
+	// topping up restarts the lockup so the added funds are locked for the whole term
+	lockup, locked := k.GetSavingsLockup(ctx, sender)
+	if locked {
+		lockup.UnlockTime = ctx.BlockTime().Add(lockup.Term)
+		k.SetSavingsLockup(ctx, sender, lockup)
+	}
+
+	total := sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, stake.Add(amount.AmountOf(config.DefaultDenom))))
+
+	err = k.depositSavings(ctx, sender, total, false)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTopUpSavings,
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) HandleWithdrawSavingsPartially(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins) error {
+	stake, found := k.GetSavingsStakeByAddress(ctx, sender)
+	if ! found {
+		return types.ErrHasNoSavings
+	}
+
+	amountInt := amount.AmountOf(config.DefaultDenom)
+	if amountInt.GT(stake) {
+		return sdkerrors.Wrapf(types.ErrInsufficientSavings, "%s > %s", amountInt, stake)
+	}
+
+	err := k.expireSavingsLockup(ctx, sender)
+	if err != nil {
+		return err
+	}
+
+	stake, reward, err := k.settleSavingsReward(ctx, sender)
+	if err != nil {
+		return err
+	}
+
+	penalty, err := k.refundSavings(ctx, sender, amountInt)
+	if err != nil {
+		return err
+	}
+
+	remaining := stake.Sub(amountInt)
+	if remaining.IsPositive() {
+		err = k.depositSavings(ctx, sender, sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, remaining)), false)
+		if err != nil {
+			return err
+		}
+	} else {
+		k.DeleteSavingsLockup(ctx, sender)
+		k.DeleteSavingsAutoCompound(ctx, sender)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawSavingsPartially,
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+			sdk.NewAttribute(types.AttributeKeyPenalty, penalty.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		),
+	})
+
+	return nil
+}
+
 // Util
 
 func (k Keeper) HasSavings(ctx sdk.Context, address sdk.AccAddress) bool {
@@ -112,8 +252,72 @@ func (k Keeper) HasSavings(ctx sdk.Context, address sdk.AccAddress) bool {
 	return found
 }
 
+func (k Keeper) GetSavingsLockupTerm(ctx sdk.Context, duration time.Duration) (types.SavingsLockupTerm, bool) {
+	for _, term := range k.SavingsLockupTerms(ctx) {
+		if term.Duration == duration {
+			return term, true
+		}
+	}
+
+	return types.SavingsLockupTerm{}, false
+}
+
 // Algorithm
 
+// weightedSavingsStake applies the multiplier of the address lockup to its stake, unlocked savings have a weight of 1
+func (k Keeper) weightedSavingsStake(ctx sdk.Context, address sdk.AccAddress, stake sdk.Int) sdk.Int {
+	lockup, found := k.GetSavingsLockup(ctx, address)
+	if ! found {
+		return stake
+	}
+
+	return stake.ToDec().MulTruncate(lockup.Multiplier).TruncateInt()
+}
+
+// refundSavings returns the amount from the savings pool, withdrawals before the unlock time are penalized
+// and the penalty is sent back to the NVRP pool
+func (k Keeper) refundSavings(ctx sdk.Context, address sdk.AccAddress, amount sdk.Int) (sdk.Coins, error) {
+	penalty := sdk.NewCoins()
+
+	lockup, found := k.GetSavingsLockup(ctx, address)
+	if found && lockup.IsLocked(ctx.BlockTime()) {
+		penaltyInt := amount.ToDec().MulTruncate(k.SavingsEarlyWithdrawalPenalty(ctx)).TruncateInt()
+		penalty = sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, penaltyInt))
+		amount = amount.Sub(penaltyInt)
+	}
+
+	if ! penalty.IsZero() {
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.SavingsModuleName, types.NvrpModuleName, penalty)
+		if err != nil {
+			return penalty, err
+		}
+	}
+
+	if amount.IsPositive() {
+		err := k.RefundSavingsStake(ctx, address, sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, amount)))
+		if err != nil {
+			return penalty, err
+		}
+	}
+
+	return penalty, nil
+}
+
+// settleSavingsReward pays out the reward accrued so far and removes the stake without moving it,
+// the caller deposits the adjusted stake again which snapshots the current rate
+func (k Keeper) settleSavingsReward(ctx sdk.Context, address sdk.AccAddress) (sdk.Int, sdk.DecCoins, error) {
+	stake, _ := k.GetSavingsStakeByAddress(ctx, address)
+
+	reward, _ := k.withdrawSavingsReward(ctx, address, false) // Error can be ignored due to supply keeper not being invoked
+
+	err := k.DistributeSavingsReward(ctx, address, reward)
+	if err != nil {
+		return stake, reward, err
+	}
+
+	return stake, reward, nil
+}
+
 func (k Keeper) depositSavings(ctx sdk.Context, address sdk.AccAddress, amount sdk.Coins, shouldMoveCoins bool) error {
 	rate := k.GetSavingsRewardRate(ctx)
 
@@ -133,11 +337,15 @@ func (k Keeper) depositSavings(ctx sdk.Context, address sdk.AccAddress, amount s
 	stake := k.GetSavingsStake(ctx)
 	k.SetSavingsStake(ctx, stake.Add(amountInt))
 
+	weightedStake := k.GetSavingsWeightedStake(ctx)
+	k.SetSavingsWeightedStake(ctx, weightedStake.Add(k.weightedSavingsStake(ctx, address, amountInt)))
+
 	return nil
 }
 
 func (k Keeper) distributeSavingsReward(ctx sdk.Context, amount sdk.Coins) bool {
-	savingsStake := k.GetSavingsStake(ctx)
+	// rewards are split by the stake weighted with the lockup multipliers
+	savingsStake := k.GetSavingsWeightedStake(ctx)
 
 	if ! savingsStake.IsZero() && amount.AmountOf(config.DefaultDenom).IsPositive() {
 		// S = S + r / T;
@@ -169,6 +377,9 @@ func (k Keeper) withdrawSavingsReward(ctx sdk.Context, address sdk.AccAddress, s
 
 	k.SetSavingsStake(ctx, totalStake.Sub(userStake))
 
+	weightedStake := k.GetSavingsWeightedStake(ctx)
+	k.SetSavingsWeightedStake(ctx, weightedStake.Sub(k.weightedSavingsStake(ctx, address, userStake)))
+
 	k.DeleteSavingsStakeByAddress(ctx, address)
 	k.DeleteSavingsRewardRateByAddress(ctx, address)
 
@@ -196,7 +407,7 @@ func (k Keeper) calculateSavingsReward(ctx sdk.Context, address sdk.AccAddress)
 		return sdk.DecCoins{}, false
 	}
 
-	reward := deposit.ToDec().MulTruncate(
+	reward := k.weightedSavingsStake(ctx, address, deposit).ToDec().MulTruncate(
 		rate.Sub(userRate),
 	)
 
@@ -225,6 +436,26 @@ func (k Keeper) SetSavingsStake(ctx sdk.Context, stake sdk.Int) {
 	store.Set(types.GetSavingsStakeKey(), k.cdc.MustMarshalBinaryBare(stake))
 }
 
+func (k Keeper) GetSavingsWeightedStake(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetSavingsWeightedStakeKey())
+	if bz == nil {
+		panic("Savings weighted stake should have been set")
+	}
+
+	var stake sdk.Int
+	k.cdc.MustUnmarshalBinaryBare(bz, &stake)
+
+	return stake
+}
+
+func (k Keeper) SetSavingsWeightedStake(ctx sdk.Context, stake sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetSavingsWeightedStakeKey(), k.cdc.MustMarshalBinaryBare(stake))
+}
+
 func (k Keeper) GetSavingsRewardRate(ctx sdk.Context) sdk.Dec {
 	store := ctx.KVStore(k.storeKey)
 
@@ -325,6 +556,59 @@ func (k Keeper) IterateSavingsStakeByAddress(ctx sdk.Context, handler func(addre
 	}
 }
 
+func (k Keeper) GetSavingsLockup(ctx sdk.Context, address sdk.AccAddress) (types.SavingsLockup, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetSavingsLockupKey(address))
+	if bz == nil {
+		return types.SavingsLockup{}, false
+	}
+
+	var lockup types.SavingsLockup
+	k.cdc.MustUnmarshalBinaryBare(bz, &lockup)
+
+	return lockup, true
+}
+
+// SetSavingsLockup stores the lockup and queues it by its unlock time, replacing the queue entry of the previous lockup
+func (k Keeper) SetSavingsLockup(ctx sdk.Context, address sdk.AccAddress, lockup types.SavingsLockup) {
+	store := ctx.KVStore(k.storeKey)
+
+	previous, found := k.GetSavingsLockup(ctx, address)
+	if found {
+		store.Delete(types.SavingsLockupQueueKey(address, previous.UnlockTime))
+	}
+
+	store.Set(types.GetSavingsLockupKey(address), k.cdc.MustMarshalBinaryBare(lockup))
+	store.Set(types.SavingsLockupQueueKey(address, lockup.UnlockTime), address)
+}
+
+func (k Keeper) DeleteSavingsLockup(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	lockup, found := k.GetSavingsLockup(ctx, address)
+	if ! found {
+		return
+	}
+
+	store.Delete(types.SavingsLockupQueueKey(address, lockup.UnlockTime))
+	store.Delete(types.GetSavingsLockupKey(address))
+}
+
+func (k Keeper) IterateSavingsLockups(ctx sdk.Context, handler func(address sdk.AccAddress, lockup types.SavingsLockup) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SavingsLockupByAddressKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var lockup types.SavingsLockup
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &lockup)
+		address := types.GetSavingsLockupAddress(iter.Key())
+		if handler(address, lockup) {
+			break
+		}
+	}
+}
+
 func (k Keeper) GetSavingsRewardEscrow(ctx sdk.Context, address sdk.AccAddress) sdk.DecCoins {
 	store := ctx.KVStore(k.storeKey)
 
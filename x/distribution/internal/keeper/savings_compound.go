@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"fmt"
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/config"
+	"github.com/DFWallet/project-anatha/x/distribution/internal/types"
+)
+
+// Handler
+
+func (k Keeper) HandleSetSavingsAutoCompound(ctx sdk.Context, sender sdk.AccAddress, enabled bool) error {
+	if ! k.HasSavings(ctx, sender) {
+		return types.ErrHasNoSavings
+	}
+
+	if enabled {
+		k.SetSavingsAutoCompound(ctx, sender)
+	} else {
+		k.DeleteSavingsAutoCompound(ctx, sender)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetSavingsAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyEnabled, fmt.Sprintf("%t", enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		),
+	})
+
+	return nil
+}
+
+// Auto Compound
+
+// CompoundAutoSavings folds the accrued reward into the principal of up to MaxSavingsCompoundsPerBlock accounts,
+// continuing after the account processed last so every account is reached in turn
+func (k Keeper) CompoundAutoSavings(ctx sdk.Context) {
+	if ctx.BlockTime().Before(k.RewardWithdrawalEnabledTime(ctx)) {
+		// rewards are escrowed until withdrawals are enabled
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	start := types.SavingsAutoCompoundKeyPrefix
+	cursor := store.Get(types.GetSavingsAutoCompoundCursorKey())
+	if cursor != nil {
+		start = append(types.GetSavingsAutoCompoundKey(cursor), 0x00)
+	}
+
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.SavingsAutoCompoundKeyPrefix))
+
+	addresses := make([]sdk.AccAddress, 0)
+	for ; iter.Valid() && len(addresses) < types.MaxSavingsCompoundsPerBlock; iter.Next() {
+		addresses = append(addresses, types.GetSavingsAutoCompoundAddress(iter.Key()))
+	}
+	reachedEnd := ! iter.Valid()
+	iter.Close()
+
+	for _, address := range addresses {
+		cacheCtx, write := ctx.CacheContext()
+
+		_, err := k.compoundSavings(cacheCtx, address)
+		if err != nil {
+			k.Logger(ctx).Error(
+				fmt.Sprintf("Failed to compound savings of %s: %s", address, err),
+			)
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	if reachedEnd {
+		store.Delete(types.GetSavingsAutoCompoundCursorKey())
+	} else {
+		store.Set(types.GetSavingsAutoCompoundCursorKey(), addresses[len(addresses) - 1])
+	}
+}
+
+func (k Keeper) compoundSavings(ctx sdk.Context, address sdk.AccAddress) (sdk.Int, error) {
+	stake, found := k.GetSavingsStakeByAddress(ctx, address)
+	if ! found {
+		return sdk.ZeroInt(), types.ErrHasNoSavings
+	}
+
+	reward, _ := k.withdrawSavingsReward(ctx, address, false) // Error can be ignored due to supply keeper not being invoked
+
+	// the reward so far was weighted with the lockup, an expired lockup is dropped so the stake is deposited again at 1x
+	lockup, found := k.GetSavingsLockup(ctx, address)
+	if found && ! lockup.IsLocked(ctx.BlockTime()) {
+		k.DeleteSavingsLockup(ctx, address)
+	}
+
+	rewardInt := k.collectSavingsReward(ctx, address, reward)
+
+	if rewardInt.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, rewardInt))
+
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.SavingsDistributionModuleName, types.SavingsModuleName, coins)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		k.Logger(ctx).Debug(
+			fmt.Sprintf("savingsdistr -> savings[%s] : %s", address, coins),
+		)
+	}
+
+	err := k.depositSavings(ctx, address, sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, stake.Add(rewardInt))), false)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompoundSavings,
+			sdk.NewAttribute(types.AttributeKeyRecipient, address.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, rewardInt.String()),
+		),
+	)
+
+	return rewardInt, nil
+}
+
+// Storage
+
+func (k Keeper) IsSavingsAutoCompound(ctx sdk.Context, address sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetSavingsAutoCompoundKey(address))
+}
+
+func (k Keeper) SetSavingsAutoCompound(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetSavingsAutoCompoundKey(address), []byte{0x01})
+}
+
+func (k Keeper) DeleteSavingsAutoCompound(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetSavingsAutoCompoundKey(address))
+}
+
+func (k Keeper) IterateSavingsAutoCompound(ctx sdk.Context, handler func(address sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SavingsAutoCompoundKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		address := types.GetSavingsAutoCompoundAddress(iter.Key())
+		if handler(address) {
+			break
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/config"
+	"github.com/DFWallet/project-anatha/x/distribution/internal/types"
+	"time"
+)
+
+// Lockup Expiry
+
+// ExpireSavingsLockups weights the stake of up to MaxSavingsLockupExpiriesPerBlock expired lockups at 1x,
+// lockups beyond the limit stay queued for the following blocks
+func (k Keeper) ExpireSavingsLockups(ctx sdk.Context) {
+	addresses := make([]sdk.AccAddress, 0)
+	k.IterateSavingsLockupQueueByTime(ctx, ctx.BlockTime(), func(address sdk.AccAddress, unlockTime time.Time) (stop bool) {
+		addresses = append(addresses, address)
+
+		return len(addresses) >= types.MaxSavingsLockupExpiriesPerBlock
+	})
+
+	for _, address := range addresses {
+		cacheCtx, write := ctx.CacheContext()
+
+		err := k.expireSavingsLockup(cacheCtx, address)
+		if err != nil {
+			k.Logger(ctx).Error(
+				fmt.Sprintf("Failed to expire savings lockup of %s: %s", address, err),
+			)
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// expireSavingsLockup settles the reward accrued at the lockup multiplier and deposits the stake again without the lockup,
+// auto compounding savings fold the reward into the stake instead. It does nothing while the lockup is still running.
+func (k Keeper) expireSavingsLockup(ctx sdk.Context, address sdk.AccAddress) error {
+	lockup, found := k.GetSavingsLockup(ctx, address)
+	if ! found || lockup.IsLocked(ctx.BlockTime()) {
+		return nil
+	}
+
+	if ! k.HasSavings(ctx, address) {
+		k.DeleteSavingsLockup(ctx, address)
+		return nil
+	}
+
+	var reward string
+
+	if k.IsSavingsAutoCompound(ctx, address) && ! ctx.BlockTime().Before(k.RewardWithdrawalEnabledTime(ctx)) {
+		compounded, err := k.compoundSavings(ctx, address)
+		if err != nil {
+			return err
+		}
+
+		reward = compounded.String()
+	} else {
+		stake, settled, err := k.settleSavingsReward(ctx, address)
+		if err != nil {
+			return err
+		}
+
+		k.DeleteSavingsLockup(ctx, address)
+
+		err = k.depositSavings(ctx, address, sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, stake)), false)
+		if err != nil {
+			return err
+		}
+
+		reward = settled.String()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExpireSavingsLockup,
+			sdk.NewAttribute(types.AttributeKeyRecipient, address.String()),
+			sdk.NewAttribute(types.AttributeKeyLockup, lockup.Term.String()),
+			sdk.NewAttribute(types.AttributeKeyReward, reward),
+		),
+	)
+
+	return nil
+}
+
+// Storage
+
+func (k Keeper) IterateSavingsLockupQueueByTime(ctx sdk.Context, endTime time.Time, cb func(address sdk.AccAddress, unlockTime time.Time) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.SavingsLockupQueueKeyPrefix, sdk.PrefixEndBytes(types.SavingsLockupQueueByTimeKey(endTime)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		address, unlockTime := types.SplitSavingsLockupQueueKey(iterator.Key())
+
+		if cb(address, unlockTime) {
+			break
+		}
+	}
+}
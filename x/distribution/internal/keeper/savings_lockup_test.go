@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DFWallet/anatha/codec"
+	"github.com/DFWallet/anatha/store"
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/anatha/x/auth"
+	"github.com/DFWallet/anatha/x/bank"
+	"github.com/DFWallet/anatha/x/params"
+	"github.com/DFWallet/anatha/x/supply"
+	"github.com/DFWallet/project-anatha/config"
+	"github.com/DFWallet/project-anatha/x/distribution/internal/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// rewards stay escrowed in the savings tests, so no coins have to be moved
+func createSavingsTestInput(t *testing.T) (sdk.Context, Keeper) {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyDistribution := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+	ms.MountStoreWithDB(keyDistribution, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Now().UTC()}, false, log.NewNopLogger())
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), map[string]bool{})
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, map[string][]string{
+		types.AmcModuleName:               nil,
+		types.NvrpModuleName:              nil,
+		types.HRAHolderRewardModuleName:   nil,
+		types.DevelopmentFundModuleName:   nil,
+		types.SecurityTokenFundModuleName: nil,
+	})
+
+	k := NewKeeper(cdc, keyDistribution, paramsKeeper.Subspace(types.DefaultParamspace), supplyKeeper, nil, nil, map[string]bool{})
+	k.SetParams(ctx, types.DefaultParams())
+	k.SetRewardWithdrawalEnabledTime(ctx, ctx.BlockTime().Add(time.Hour * 24 * 365 * 10))
+
+	k.SetSavingsStake(ctx, sdk.ZeroInt())
+	k.SetSavingsWeightedStake(ctx, sdk.ZeroInt())
+	k.SetSavingsRewardRate(ctx, sdk.ZeroDec())
+
+	return ctx, k
+}
+
+// requireSavingsWeightConsistent checks the weighted total against the sum of the weighted stakes of every saver
+func requireSavingsWeightConsistent(t *testing.T, ctx sdk.Context, k Keeper) {
+	total := sdk.ZeroInt()
+	weighted := sdk.ZeroInt()
+	k.IterateSavingsStakeByAddress(ctx, func(address sdk.AccAddress, stake sdk.Int) (stop bool) {
+		total = total.Add(stake)
+		weighted = weighted.Add(k.weightedSavingsStake(ctx, address, stake))
+		return false
+	})
+
+	require.Equal(t, total, k.GetSavingsStake(ctx))
+	require.Equal(t, weighted, k.GetSavingsWeightedStake(ctx))
+}
+
+func TestExpireSavingsLockups(t *testing.T) {
+	ctx, k := createSavingsTestInput(t)
+
+	locked := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	unlocked := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	stake := sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, 1000))
+
+	term := types.NewSavingsLockupTerm(time.Hour * 24 * 90, sdk.NewDecWithPrec(125, 2))
+	k.SetSavingsLockup(ctx, locked, types.NewSavingsLockup(term, ctx.BlockTime()))
+	require.NoError(t, k.depositSavings(ctx, locked, stake, false))
+	require.NoError(t, k.depositSavings(ctx, unlocked, stake, false))
+
+	require.Equal(t, sdk.NewInt(2250), k.GetSavingsWeightedStake(ctx))
+	requireSavingsWeightConsistent(t, ctx, k)
+
+	// 0.2 per weighted unit, 250 for the locked and 200 for the unlocked saver
+	require.True(t, k.distributeSavingsReward(ctx, sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, 450))))
+
+	// still locked a second before the unlock time
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(term.Duration - time.Second))
+	k.ExpireSavingsLockups(ctx)
+
+	_, found := k.GetSavingsLockup(ctx, locked)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(2250), k.GetSavingsWeightedStake(ctx))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	k.ExpireSavingsLockups(ctx)
+
+	_, found = k.GetSavingsLockup(ctx, locked)
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(2000), k.GetSavingsWeightedStake(ctx))
+	requireSavingsWeightConsistent(t, ctx, k)
+
+	require.Equal(t, sdk.NewDec(250), k.GetSavingsRewardEscrow(ctx, locked).AmountOf(config.DefaultDenom))
+
+	queued := 0
+	k.IterateSavingsLockupQueueByTime(ctx, ctx.BlockTime().Add(term.Duration), func(address sdk.AccAddress, unlockTime time.Time) (stop bool) {
+		queued++
+		return false
+	})
+	require.Zero(t, queued)
+
+	// rewards are split by the unweighted stake from now on
+	require.True(t, k.distributeSavingsReward(ctx, sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, 400))))
+
+	reward, _ := k.calculateSavingsReward(ctx, locked)
+	require.Equal(t, sdk.NewDec(200), reward.AmountOf(config.DefaultDenom))
+
+	reward, _ = k.calculateSavingsReward(ctx, unlocked)
+	require.Equal(t, sdk.NewDec(400), reward.AmountOf(config.DefaultDenom))
+}
+
+func TestTopUpSavingsKeepsWeightConsistent(t *testing.T) {
+	ctx, k := createSavingsTestInput(t)
+
+	address := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	term := types.NewSavingsLockupTerm(time.Hour * 24 * 30, sdk.NewDecWithPrec(11, 1))
+	k.SetSavingsLockup(ctx, address, types.NewSavingsLockup(term, ctx.BlockTime()))
+	require.NoError(t, k.depositSavings(ctx, address, sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, 1000)), false))
+
+	// re-depositing with a restarted lockup replaces the queue entry instead of adding one
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	stake, _, err := k.settleSavingsReward(ctx, address)
+	require.NoError(t, err)
+	k.SetSavingsLockup(ctx, address, types.NewSavingsLockup(term, ctx.BlockTime()))
+	require.NoError(t, k.depositSavings(ctx, address, sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, stake.AddRaw(1000))), false))
+
+	require.Equal(t, sdk.NewInt(2200), k.GetSavingsWeightedStake(ctx))
+	requireSavingsWeightConsistent(t, ctx, k)
+
+	queued := 0
+	k.IterateSavingsLockupQueueByTime(ctx, ctx.BlockTime().Add(term.Duration), func(address sdk.AccAddress, unlockTime time.Time) (stop bool) {
+		queued++
+		return false
+	})
+	require.Equal(t, 1, queued)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(term.Duration))
+	k.ExpireSavingsLockups(ctx)
+
+	require.Equal(t, sdk.NewInt(2000), k.GetSavingsWeightedStake(ctx))
+	requireSavingsWeightConsistent(t, ctx, k)
+}
@@ -86,13 +86,14 @@ func (k Keeper) DistributeNameReward(ctx sdk.Context, recipient sdk.AccAddress,
 	}
 
 	toTransfer := sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, rewardInt))
+	withdrawAddress := k.GetWithdrawAddress(ctx, recipient)
 
-	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.HRAHolderRewardModuleName, recipient , toTransfer)
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.HRAHolderRewardModuleName, withdrawAddress, toTransfer)
 	if err != nil {
 		return err
 	}
 	k.Logger(ctx).Debug(
-		fmt.Sprintf("hhrm -> %s : %s", recipient, toTransfer),
+		fmt.Sprintf("hhrm -> %s : %s", withdrawAddress, toTransfer),
 	)
 
 	return nil
@@ -115,6 +116,27 @@ func (k Keeper) DistributeSavingsReward(ctx sdk.Context, recipient sdk.AccAddres
 		return nil
 	}
 
+	rewardInt := k.collectSavingsReward(ctx, recipient, amount)
+
+	if ! rewardInt.IsZero() {
+		toTransfer := sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, rewardInt))
+		withdrawAddress := k.GetWithdrawAddress(ctx, recipient)
+
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.SavingsDistributionModuleName, withdrawAddress, toTransfer)
+		if err != nil {
+			return err
+		}
+		k.Logger(ctx).Debug(
+			fmt.Sprintf("savingsdistr -> %s : %s", withdrawAddress, toTransfer),
+		)
+	}
+
+	return nil
+}
+
+// collectSavingsReward adds the escrowed reward to the amount and returns the whole pin that can be paid out,
+// fractions are kept as the recipient's leftover
+func (k Keeper) collectSavingsReward(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.DecCoins) sdk.Int {
 	// we add the balance of the escrow account to the current distribution and delete the escrow account
 	inEscrow := k.GetSavingsRewardEscrow(ctx, recipient)
 	amount = amount.Add(inEscrow...)
@@ -151,19 +173,7 @@ func (k Keeper) DistributeSavingsReward(ctx sdk.Context, recipient sdk.AccAddres
 		k.SetSavingsRewardLeftover(ctx, recipient, currentLeftover)
 	}
 
-	if ! rewardInt.IsZero() {
-		toTransfer := sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, rewardInt))
-
-		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.SavingsDistributionModuleName, recipient , toTransfer)
-		if err != nil {
-			return err
-		}
-		k.Logger(ctx).Debug(
-			fmt.Sprintf("savingsdistr -> %s : %s", recipient, toTransfer),
-		)
-	}
-
-	return nil
+	return rewardInt
 }
 
 func (k Keeper) RefundSavingsStake(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) error {
@@ -21,7 +21,7 @@ func (k Keeper) HandleWithdrawValidatorReward(ctx sdk.Context, valAddr sdk.ValAd
 	k.SetValidatorAccumulatedRewards(ctx, valAddr, remainder)
 
 	if ! rewards.IsZero() {
-		accAddr := sdk.AccAddress(valAddr)
+		accAddr := k.GetWithdrawAddress(ctx, sdk.AccAddress(valAddr))
 		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.NvrpDistributionModuleName, accAddr, rewards)
 		if err != nil {
 			return err
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/DFWallet/anatha/types"
+	sdkerrors "github.com/DFWallet/anatha/types/errors"
+	"github.com/DFWallet/project-anatha/x/distribution/internal/types"
+)
+
+// Handler
+
+func (k Keeper) HandleSetWithdrawAddress(ctx sdk.Context, sender sdk.AccAddress, withdrawAddress sdk.AccAddress) error {
+	if k.blacklistedAddrs[withdrawAddress.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is blacklisted from receiving external funds", withdrawAddress)
+	}
+
+	if withdrawAddress.Equals(sender) {
+		k.DeleteWithdrawAddress(ctx, sender)
+	} else {
+		k.SetWithdrawAddress(ctx, sender, withdrawAddress)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddress.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		),
+	})
+
+	return nil
+}
+
+// Storage
+
+// GetWithdrawAddress returns the address receiving the rewards of an account, the account itself if none was set
+func (k Keeper) GetWithdrawAddress(ctx sdk.Context, address sdk.AccAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetWithdrawAddressKey(address))
+	if bz == nil {
+		return address
+	}
+
+	return sdk.AccAddress(bz)
+}
+
+func (k Keeper) SetWithdrawAddress(ctx sdk.Context, address sdk.AccAddress, withdrawAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetWithdrawAddressKey(address), withdrawAddress.Bytes())
+}
+
+func (k Keeper) DeleteWithdrawAddress(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetWithdrawAddressKey(address))
+}
+
+func (k Keeper) IterateWithdrawAddresses(ctx sdk.Context, handler func(address sdk.AccAddress, withdrawAddress sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.WithdrawAddressKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		address := types.GetWithdrawAddressAddress(iter.Key())
+		if handler(address, sdk.AccAddress(iter.Value())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/DFWallet/anatha/types"
+	"time"
+)
+
+const (
+	// RewardRateWindow is the minimum period the reward rates are observed over for the projections
+	RewardRateWindow = time.Hour * 24
+
+	year = time.Hour * 24 * 365
+)
+
+// RewardRateCheckpoint records the reward accumulators and the NVRP inflow total at a point in time
+type RewardRateCheckpoint struct {
+	SavingsRewardRate sdk.Dec   `json:"savings_reward_rate" yaml:"savings_reward_rate"`
+	NameRewardRate    sdk.Dec   `json:"name_reward_rate" yaml:"name_reward_rate"`
+	NvrpInflow        sdk.Int   `json:"nvrp_inflow" yaml:"nvrp_inflow"`
+	Time              time.Time `json:"time" yaml:"time"`
+}
+
+func NewRewardRateCheckpoint(savingsRewardRate sdk.Dec, nameRewardRate sdk.Dec, nvrpInflow sdk.Int, time time.Time) RewardRateCheckpoint {
+	return RewardRateCheckpoint{
+		SavingsRewardRate: savingsRewardRate,
+		NameRewardRate:    nameRewardRate,
+		NvrpInflow:        nvrpInflow,
+		Time:              time,
+	}
+}
+
+// Annualize scales the growth of an accumulator between two points in time to a year
+func Annualize(from sdk.Dec, to sdk.Dec, elapsed time.Duration) sdk.Dec {
+	if elapsed <= 0 || to.LT(from) {
+		return sdk.ZeroDec()
+	}
+
+	return to.Sub(from).MulInt64(int64(year)).QuoInt64(int64(elapsed))
+}
+
+type QueryResAnalytics struct {
+	Since time.Time `json:"since" yaml:"since"`
+
+	NvrpInflowPerYear sdk.Dec `json:"nvrp_inflow_per_year" yaml:"nvrp_inflow_per_year"`
+	SavingsStake      sdk.Int `json:"savings_stake" yaml:"savings_stake"`
+	BondedTokens      sdk.Int `json:"bonded_tokens" yaml:"bonded_tokens"`
+	SavingsShare      sdk.Dec `json:"savings_share" yaml:"savings_share"`
+	ValidatorShare    sdk.Dec `json:"validator_share" yaml:"validator_share"`
+	SavingsApr        sdk.Dec `json:"savings_apr" yaml:"savings_apr"`
+
+	NameStake               sdk.Dec   `json:"name_stake" yaml:"name_stake"`
+	NameRewardPerYear       sdk.Dec   `json:"name_reward_per_year" yaml:"name_reward_per_year"`
+	PendingNameDistribution sdk.Coins `json:"pending_name_distribution" yaml:"pending_name_distribution"`
+}
+
+func (r QueryResAnalytics) String() string {
+	return fmt.Sprintf(`Since: %s
+NVRP Inflow Per Year: %s
+Savings Stake: %s
+Bonded Tokens: %s
+Savings Share: %s
+Validator Share: %s
+Savings APR: %s
+Name Stake: %s
+Name Reward Per Year: %s
+Pending Name Distribution: %s`,
+		r.Since, r.NvrpInflowPerYear, r.SavingsStake, r.BondedTokens, r.SavingsShare, r.ValidatorShare, r.SavingsApr,
+		r.NameStake, r.NameRewardPerYear, r.PendingNameDistribution,
+	)
+}
+
+type QuerySavingsProjectionParams struct {
+	Amount sdk.Int       `json:"amount" yaml:"amount"`
+	Lockup time.Duration `json:"lockup" yaml:"lockup"`
+}
+
+func NewQuerySavingsProjectionParams(amount sdk.Int, lockup time.Duration) QuerySavingsProjectionParams {
+	return QuerySavingsProjectionParams{
+		Amount: amount,
+		Lockup: lockup,
+	}
+}
+
+type QueryResSavingsProjection struct {
+	Amount        sdk.Int       `json:"amount" yaml:"amount"`
+	Lockup        time.Duration `json:"lockup" yaml:"lockup"`
+	Multiplier    sdk.Dec       `json:"multiplier" yaml:"multiplier"`
+	SavingsShare  sdk.Dec       `json:"savings_share" yaml:"savings_share"`
+	RewardPerYear sdk.Dec       `json:"reward_per_year" yaml:"reward_per_year"`
+	Apr           sdk.Dec       `json:"apr" yaml:"apr"`
+}
+
+func (r QueryResSavingsProjection) String() string {
+	return fmt.Sprintf(`Amount: %s
+Lockup: %s
+Multiplier: %s
+Savings Share: %s
+Reward Per Year: %s
+APR: %s`, r.Amount, r.Lockup, r.Multiplier, r.SavingsShare, r.RewardPerYear, r.Apr)
+}
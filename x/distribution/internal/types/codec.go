@@ -14,6 +14,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgDepositSavings{}, "distribution/DepositSavings", nil)
 	cdc.RegisterConcrete(MsgWithdrawSavings{}, "distribution/WithdrawSavings", nil)
 	cdc.RegisterConcrete(MsgWithdrawSavingsInterest{}, "distribution/WithdrawSavingsInterest", nil)
+	cdc.RegisterConcrete(MsgTopUpSavings{}, "distribution/TopUpSavings", nil)
+	cdc.RegisterConcrete(MsgWithdrawSavingsPartially{}, "distribution/WithdrawSavingsPartially", nil)
+	cdc.RegisterConcrete(MsgSetSavingsAutoCompound{}, "distribution/SetSavingsAutoCompound", nil)
+	cdc.RegisterConcrete(MsgSetWithdrawAddress{}, "distribution/SetWithdrawAddress", nil)
 }
 
 var ModuleCdc *codec.Codec
@@ -11,4 +11,6 @@ var (
 
 	ErrAlreadyHasSavings        = sdkerrors.Register(ModuleName, 103, "savings already active")
 	ErrHasNoSavings             = sdkerrors.Register(ModuleName, 104, "user has no savings")
+	ErrInsufficientSavings      = sdkerrors.Register(ModuleName, 105, "withdrawal exceeds the savings deposit")
+	ErrInvalidSavingsLockup     = sdkerrors.Register(ModuleName, 106, "invalid savings lockup term")
 )
\ No newline at end of file
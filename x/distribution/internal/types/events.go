@@ -8,6 +8,12 @@ const (
 	EventTypeDepositSavings					= "deposit_savings"
 	EventTypeWithdrawSavings				= "withdraw_savings"
 	EventTypeWithdrawSavingsInterest		= "withdraw_savings_interest"
+	EventTypeTopUpSavings					= "top_up_savings"
+	EventTypeWithdrawSavingsPartially		= "withdraw_savings_partially"
+	EventTypeSetSavingsAutoCompound			= "set_savings_auto_compound"
+	EventTypeCompoundSavings				= "compound_savings"
+	EventTypeExpireSavingsLockup			= "expire_savings_lockup"
+	EventTypeSetWithdrawAddress				= "set_withdraw_address"
 
 	AttributeKeyAmount					= "amount"
 	AttributeKeyRecipient				= "recipient"
@@ -15,6 +21,11 @@ const (
 	AttributeKeyTitle					= "title"
 	AttributeKeyDescription				= "description"
 	AttributeKeyReward					= "reward"
+	AttributeKeyPenalty					= "penalty"
+	AttributeKeyLockup					= "lockup"
+	AttributeKeyUnlockTime				= "unlock_time"
+	AttributeKeyEnabled					= "enabled"
+	AttributeKeyWithdrawAddress			= "withdraw_address"
 
 	AttributeValueModule = ModuleName
 )
@@ -46,6 +46,16 @@ type SavingsRewardEscrowRecord struct {
 	Amount sdk.DecCoins `json:"amount" yaml:"amount"`
 }
 
+type SavingsLockupRecord struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Lockup  SavingsLockup  `json:"lockup" yaml:"lockup"`
+}
+
+type WithdrawAddressRecord struct {
+	Address         sdk.AccAddress `json:"address" yaml:"address"`
+	WithdrawAddress sdk.AccAddress `json:"withdraw_address" yaml:"withdraw_address"`
+}
+
 type SavingsRewardLeftoverRecord struct {
 	Address sdk.AccAddress `json:"address" yaml:"address"`
 	Amount sdk.Dec `json:"amount" yaml:"amount"`
@@ -68,16 +78,27 @@ type GenesisState struct {
 	AddressSavingsStake []AddressSavingsStakeRecord `json:"address_savings_stake" yaml:"address_savings_stake"`
 	SavingsRewardEscrow []SavingsRewardEscrowRecord `json:"savings_reward_escrow" yaml:"savings_reward_escrow"`
 	SavingsRewardLeftover []SavingsRewardLeftoverRecord `json:"savings_reward_leftover" yaml:"savings_reward_leftover"`
+	SavingsWeightedStake sdk.Int `json:"savings_weighted_stake" yaml:"savings_weighted_stake"`
+	SavingsLockups []SavingsLockupRecord `json:"savings_lockups" yaml:"savings_lockups"`
+	SavingsAutoCompound []sdk.AccAddress `json:"savings_auto_compound" yaml:"savings_auto_compound"`
 
 	ValidatorAccumulatedRewards []ValidatorAccumulatedRewardRecord `json:"validator_accumulated_rewards" yaml:"validator_accumulated_rewards"`
 	NvrpRemainder sdk.DecCoins `json:"nvrp_remainder" yaml:"nvrp_remainder"`
+
+	WithdrawAddresses []WithdrawAddressRecord `json:"withdraw_addresses" yaml:"withdraw_addresses"`
+
+	NvrpInflow sdk.Int `json:"nvrp_inflow" yaml:"nvrp_inflow"`
+	NvrpCarryover sdk.Int `json:"nvrp_carryover" yaml:"nvrp_carryover"`
+	RewardRateCheckpoints []RewardRateCheckpoint `json:"reward_rate_checkpoints" yaml:"reward_rate_checkpoints"` // oldest first, at most two
 }
 
 func NewGenesisState(params Params, nameStake sdk.Dec, nameRewardRate sdk.Dec, addressNameRewardRates []AddressNameRewardRateRecord,
 	pendingNameDistribution sdk.Coins, nameDepositQueue []NameDepositQueueRecord, nameRewardEscrow []NameRewardEscrowRecord, nameRewardLeftover []NameRewardLeftoverRecord,
 	savingsStake sdk.Int, savingsRewardRate sdk.Dec, addressSavingsRewardRates []AddressSavingsRewardRateRecord, addressSavingsStake []AddressSavingsStakeRecord,
 	savingsRewardEscrow []SavingsRewardEscrowRecord, savingsRewardLeftover []SavingsRewardLeftoverRecord,
-	validatorAccumulatedRewards []ValidatorAccumulatedRewardRecord, nvrpRemainder sdk.DecCoins) GenesisState {
+	savingsWeightedStake sdk.Int, savingsLockups []SavingsLockupRecord, savingsAutoCompound []sdk.AccAddress,
+	validatorAccumulatedRewards []ValidatorAccumulatedRewardRecord, nvrpRemainder sdk.DecCoins, withdrawAddresses []WithdrawAddressRecord,
+	nvrpInflow sdk.Int, nvrpCarryover sdk.Int, rewardRateCheckpoints []RewardRateCheckpoint) GenesisState {
 
 	return GenesisState{
 		Params: params,
@@ -96,9 +117,18 @@ func NewGenesisState(params Params, nameStake sdk.Dec, nameRewardRate sdk.Dec, a
 		AddressSavingsStake: addressSavingsStake,
 		SavingsRewardEscrow: savingsRewardEscrow,
 		SavingsRewardLeftover: savingsRewardLeftover,
+		SavingsWeightedStake: savingsWeightedStake,
+		SavingsLockups: savingsLockups,
+		SavingsAutoCompound: savingsAutoCompound,
 
 		ValidatorAccumulatedRewards: validatorAccumulatedRewards,
 		NvrpRemainder: nvrpRemainder,
+
+		WithdrawAddresses: withdrawAddresses,
+
+		NvrpInflow: nvrpInflow,
+		NvrpCarryover: nvrpCarryover,
+		RewardRateCheckpoints: rewardRateCheckpoints,
 	}
 }
 
@@ -120,9 +150,18 @@ func DefaultGenesisState() GenesisState {
 		AddressSavingsStake: []AddressSavingsStakeRecord{},
 		SavingsRewardEscrow: []SavingsRewardEscrowRecord{},
 		SavingsRewardLeftover: []SavingsRewardLeftoverRecord{},
+		SavingsWeightedStake: sdk.ZeroInt(),
+		SavingsLockups: []SavingsLockupRecord{},
+		SavingsAutoCompound: []sdk.AccAddress{},
 
 		ValidatorAccumulatedRewards: []ValidatorAccumulatedRewardRecord{},
 		NvrpRemainder: sdk.NewDecCoins(),
+
+		WithdrawAddresses: []WithdrawAddressRecord{},
+
+		NvrpInflow: sdk.ZeroInt(),
+		NvrpCarryover: sdk.ZeroInt(),
+		RewardRateCheckpoints: []RewardRateCheckpoint{},
 	}
 }
 
@@ -200,6 +239,25 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	if ! data.SavingsWeightedStake.IsNil() && data.SavingsWeightedStake.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, data.SavingsWeightedStake.String())
+	}
+
+	for _, record := range data.SavingsLockups {
+		if record.Address.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, record.Address.String())
+		}
+		if record.Lockup.Term <= 0 || record.Lockup.Multiplier.IsNil() || record.Lockup.Multiplier.LT(sdk.OneDec()) {
+			return sdkerrors.Wrap(ErrInvalidSavingsLockup, record.Lockup.String())
+		}
+	}
+
+	for _, address := range data.SavingsAutoCompound {
+		if address.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, address.String())
+		}
+	}
+
 	for _, record := range data.ValidatorAccumulatedRewards {
 		if record.ValidatorAddress.Empty() {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, record.ValidatorAddress.String())
@@ -210,5 +268,32 @@ func ValidateGenesis(data GenesisState) error {
 
 	}
 
+	for _, record := range data.WithdrawAddresses {
+		if record.Address.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, record.Address.String())
+		}
+		if record.WithdrawAddress.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, record.WithdrawAddress.String())
+		}
+	}
+
+	if ! data.NvrpInflow.IsNil() && data.NvrpInflow.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, data.NvrpInflow.String())
+	}
+
+	if ! data.NvrpCarryover.IsNil() && data.NvrpCarryover.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, data.NvrpCarryover.String())
+	}
+
+	if len(data.RewardRateCheckpoints) > 2 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "at most two reward rate checkpoints")
+	}
+
+	for _, checkpoint := range data.RewardRateCheckpoints {
+		if checkpoint.SavingsRewardRate.IsNil() || checkpoint.NameRewardRate.IsNil() || checkpoint.NvrpInflow.IsNil() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "incomplete reward rate checkpoint")
+		}
+	}
+
 	return nil
 }
\ No newline at end of file
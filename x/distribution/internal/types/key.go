@@ -41,9 +41,21 @@ var (
 	SavingsRewardRateByAddressKeyPrefix  = []byte{0x23}
 	SavingsRewardEscrowKeyPrefix         = []byte{0x24}
 	SavingsRewardLeftoverKeyPrefix       = []byte{0x25}
+	SavingsWeightedStakeKey              = []byte{0x26}
+	SavingsLockupByAddressKeyPrefix      = []byte{0x27}
+	SavingsAutoCompoundKeyPrefix         = []byte{0x28}
+	SavingsAutoCompoundCursorKey         = []byte{0x29}
+	SavingsLockupQueueKeyPrefix          = []byte{0x2A}
 
 	ValidatorAccumulatedRewardsKeyPrefix = []byte{0x30} // key for accumulated validator rewards
 	NvrpdRemainderKey                    = []byte{0x31}
+	NvrpInflowKey                        = []byte{0x32}
+	NvrpCarryoverKey                     = []byte{0x33}
+
+	RewardRateCheckpointPreviousKey      = []byte{0x40}
+	RewardRateCheckpointLatestKey        = []byte{0x41}
+
+	WithdrawAddressKeyPrefix             = []byte{0x50}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -126,6 +138,14 @@ func GetNvrpdRemainderKey() []byte {
 	return NvrpdRemainderKey
 }
 
+func GetNvrpInflowKey() []byte {
+	return NvrpInflowKey
+}
+
+func GetNvrpCarryoverKey() []byte {
+	return NvrpCarryoverKey
+}
+
 // Savings
 
 func GetSavingsStakeKey() []byte {
@@ -184,6 +204,62 @@ func GetSavingsRewardLeftoverAddress(key []byte) (address sdk.AccAddress) {
 	return sdk.AccAddress(addr)
 }
 
+func GetSavingsWeightedStakeKey() []byte {
+	return SavingsWeightedStakeKey
+}
+
+func GetSavingsLockupKey(address sdk.AccAddress) []byte {
+	return append(SavingsLockupByAddressKeyPrefix, address...)
+}
+
+func GetSavingsLockupAddress(key []byte) (address sdk.AccAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
+
+func SavingsLockupQueueByTimeKey(unlockTime time.Time) []byte {
+	return append(SavingsLockupQueueKeyPrefix, sdk.FormatTimeBytes(unlockTime)...)
+}
+
+func SavingsLockupQueueKey(address sdk.AccAddress, unlockTime time.Time) []byte {
+	return append(SavingsLockupQueueByTimeKey(unlockTime), address...)
+}
+
+func SplitSavingsLockupQueueKey(key []byte) (address sdk.AccAddress, unlockTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+func GetSavingsAutoCompoundKey(address sdk.AccAddress) []byte {
+	return append(SavingsAutoCompoundKeyPrefix, address...)
+}
+
+func GetSavingsAutoCompoundAddress(key []byte) (address sdk.AccAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
+
+func GetSavingsAutoCompoundCursorKey() []byte {
+	return SavingsAutoCompoundCursorKey
+}
+
+func GetWithdrawAddressKey(address sdk.AccAddress) []byte {
+	return append(WithdrawAddressKeyPrefix, address...)
+}
+
+func GetWithdrawAddressAddress(key []byte) (address sdk.AccAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
+
 // Internal
 
 func splitKeyWithTime(key []byte) (address sdk.AccAddress, endTime time.Time) {
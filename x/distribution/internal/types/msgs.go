@@ -4,6 +4,7 @@ import (
 	sdk "github.com/DFWallet/anatha/types"
 	sdkerrors "github.com/DFWallet/anatha/types/errors"
 	"github.com/DFWallet/project-anatha/config"
+	"time"
 )
 
 var _, _ sdk.Msg = &MsgWithdrawNameReward{}, &MsgWithdrawValidatorReward{}
@@ -73,12 +74,14 @@ func (msg MsgWithdrawValidatorReward) GetSigners() []sdk.AccAddress {
 type MsgDepositSavings struct {
 	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
 	Amount sdk.Coins `json:"amount" yaml:"amount"`
+	Lockup time.Duration `json:"lockup" yaml:"lockup"`
 }
 
-func NewMsgDepositSavings(sender sdk.AccAddress, amount sdk.Coins) MsgDepositSavings {
+func NewMsgDepositSavings(sender sdk.AccAddress, amount sdk.Coins, lockup time.Duration) MsgDepositSavings {
 	return MsgDepositSavings{
 		Sender: sender,
 		Amount: amount,
+		Lockup: lockup,
 	}
 }
 
@@ -93,6 +96,9 @@ func (msg MsgDepositSavings) ValidateBasic() error {
 	if msg.Amount.AmountOf(config.DefaultDenom).IsZero() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
 	}
+	if msg.Lockup < 0 {
+		return sdkerrors.Wrap(ErrInvalidSavingsLockup, msg.Lockup.String())
+	}
 
 	return nil
 }
@@ -165,4 +171,144 @@ func (msg MsgWithdrawSavingsInterest) GetSignBytes() []byte {
 
 func (msg MsgWithdrawSavingsInterest) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
-}
\ No newline at end of file
+}
+// MsgTopUpSavings
+type MsgTopUpSavings struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount sdk.Coins `json:"amount" yaml:"amount"`
+}
+
+func NewMsgTopUpSavings(sender sdk.AccAddress, amount sdk.Coins) MsgTopUpSavings {
+	return MsgTopUpSavings{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+func (msg MsgTopUpSavings) Route() string { return RouterKey }
+
+func (msg MsgTopUpSavings) Type() string { return "top_up_savings" }
+
+func (msg MsgTopUpSavings) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender.String())
+	}
+	if ! msg.Amount.IsValid() || ! msg.Amount.AmountOf(config.DefaultDenom).IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+func (msg MsgTopUpSavings) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgTopUpSavings) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgWithdrawSavingsPartially
+type MsgWithdrawSavingsPartially struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount sdk.Coins `json:"amount" yaml:"amount"`
+}
+
+func NewMsgWithdrawSavingsPartially(sender sdk.AccAddress, amount sdk.Coins) MsgWithdrawSavingsPartially {
+	return MsgWithdrawSavingsPartially{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+func (msg MsgWithdrawSavingsPartially) Route() string { return RouterKey }
+
+func (msg MsgWithdrawSavingsPartially) Type() string { return "withdraw_savings_partially" }
+
+func (msg MsgWithdrawSavingsPartially) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender.String())
+	}
+	if ! msg.Amount.IsValid() || ! msg.Amount.AmountOf(config.DefaultDenom).IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawSavingsPartially) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgWithdrawSavingsPartially) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgSetSavingsAutoCompound
+type MsgSetSavingsAutoCompound struct {
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Enabled bool           `json:"enabled" yaml:"enabled"`
+}
+
+func NewMsgSetSavingsAutoCompound(sender sdk.AccAddress, enabled bool) MsgSetSavingsAutoCompound {
+	return MsgSetSavingsAutoCompound{
+		Sender:  sender,
+		Enabled: enabled,
+	}
+}
+
+func (msg MsgSetSavingsAutoCompound) Route() string { return RouterKey }
+
+func (msg MsgSetSavingsAutoCompound) Type() string { return "set_savings_auto_compound" }
+
+func (msg MsgSetSavingsAutoCompound) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender.String())
+	}
+
+	return nil
+}
+
+func (msg MsgSetSavingsAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetSavingsAutoCompound) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgSetWithdrawAddress
+type MsgSetWithdrawAddress struct {
+	Sender          sdk.AccAddress `json:"sender" yaml:"sender"`
+	WithdrawAddress sdk.AccAddress `json:"withdraw_address" yaml:"withdraw_address"`
+}
+
+func NewMsgSetWithdrawAddress(sender sdk.AccAddress, withdrawAddress sdk.AccAddress) MsgSetWithdrawAddress {
+	return MsgSetWithdrawAddress{
+		Sender:          sender,
+		WithdrawAddress: withdrawAddress,
+	}
+}
+
+func (msg MsgSetWithdrawAddress) Route() string { return RouterKey }
+
+func (msg MsgSetWithdrawAddress) Type() string { return "set_withdraw_address" }
+
+func (msg MsgSetWithdrawAddress) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender.String())
+	}
+	if msg.WithdrawAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.WithdrawAddress.String())
+	}
+
+	return nil
+}
+
+func (msg MsgSetWithdrawAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetWithdrawAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
@@ -19,6 +19,13 @@ var (
 	DefaultDevelopmentFundShare = sdk.NewDecWithPrec(25, 2)
 	DefaultSecurityTokenFundShare = sdk.NewDecWithPrec(25, 2)
 
+	DefaultSavingsLockupTerms = []SavingsLockupTerm{
+		NewSavingsLockupTerm(time.Hour * 24 * 30, sdk.NewDecWithPrec(11, 1)),
+		NewSavingsLockupTerm(time.Hour * 24 * 90, sdk.NewDecWithPrec(125, 2)),
+		NewSavingsLockupTerm(time.Hour * 24 * 365, sdk.NewDecWithPrec(15, 1)),
+	}
+	DefaultSavingsEarlyWithdrawalPenalty = sdk.NewDecWithPrec(1, 1)
+
 	KeyNameDepositDelay              = []byte("NameDepositDelay")
 	KeyRewardWithdrawalBlockedPeriod = []byte("RewardWithdrawalBlockedPeriod")
 	KeyRewardWithdrawalEnabledTime   = []byte("RewardWithdrawalEnabledTime")
@@ -27,6 +34,9 @@ var (
 
 	KeyDevelopmentFundShare          = []byte("DevelopmentFundShare")
 	KeySecurityTokenFundShare        = []byte("SecurityTokenFundShare")
+
+	KeySavingsLockupTerms              = []byte("SavingsLockupTerms")
+	KeySavingsEarlyWithdrawalPenalty   = []byte("SavingsEarlyWithdrawalPenalty")
 )
 
 func ParamKeyTable() params.KeyTable {
@@ -41,6 +51,9 @@ type Params struct {
 
 	DevelopmentFundShare          sdk.Dec `json:"development_fund_share" yaml:"development_fund_share"`
 	SecurityTokenFundShare        sdk.Dec `json:"security_token_fund_share" yaml:"security_token_fund_share"`
+
+	SavingsLockupTerms            []SavingsLockupTerm `json:"savings_lockup_terms" yaml:"savings_lockup_terms"`
+	SavingsEarlyWithdrawalPenalty sdk.Dec `json:"savings_early_withdrawal_penalty" yaml:"savings_early_withdrawal_penalty"`
 }
 
 func NewParams(nameDepositDelay time.Duration) Params {
@@ -57,6 +70,8 @@ func DefaultParams() Params {
 		DefaultSavingsSplitAdjustment,
 		DefaultDevelopmentFundShare,
 		DefaultSecurityTokenFundShare,
+		DefaultSavingsLockupTerms,
+		DefaultSavingsEarlyWithdrawalPenalty,
 	}
 }
 
@@ -68,7 +83,10 @@ func (p Params) String() string {
 	Savings Split Adjustment: %s
 	Default Development Fund Share: %s
 	Default Security Token Fund Share: %s
-	`, p.NameDepositDelay, p.RewardWithdrawalBlockedPeriod, p.RewardWithdrawalEnabledTime, p.SavingsSplitAdjustment, p.DevelopmentFundShare, p.SecurityTokenFundShare)
+	Savings Lockup Terms: %s
+	Savings Early Withdrawal Penalty: %s
+	`, p.NameDepositDelay, p.RewardWithdrawalBlockedPeriod, p.RewardWithdrawalEnabledTime, p.SavingsSplitAdjustment, p.DevelopmentFundShare, p.SecurityTokenFundShare,
+	p.SavingsLockupTerms, p.SavingsEarlyWithdrawalPenalty)
 }
 
 func (p Params) Validate() error {
@@ -96,6 +114,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateSavingsLockupTerms(p.SavingsLockupTerms); err != nil {
+		return err
+	}
+
+	if err := validatePercentage(p.SavingsEarlyWithdrawalPenalty); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -107,6 +133,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeySavingsSplitAdjustment, &p.SavingsSplitAdjustment, validatePercentage),
 		params.NewParamSetPair(KeyDevelopmentFundShare, &p.DevelopmentFundShare, validateDevelopmentFundShare),
 		params.NewParamSetPair(KeySecurityTokenFundShare, &p.SecurityTokenFundShare, validateSecurityTokenFundShare),
+		params.NewParamSetPair(KeySavingsLockupTerms, &p.SavingsLockupTerms, validateSavingsLockupTerms),
+		params.NewParamSetPair(KeySavingsEarlyWithdrawalPenalty, &p.SavingsEarlyWithdrawalPenalty, validatePercentage),
 	}
 }
 
@@ -177,4 +205,28 @@ func validateSecurityTokenFundShare(i interface{}) error {
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+func validateSavingsLockupTerms(i interface{}) error {
+	v, ok := i.([]SavingsLockupTerm)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[time.Duration]bool)
+	for _, term := range v {
+		if term.Duration <= 0 {
+			return fmt.Errorf("lockup duration must be positive: %s", term.Duration)
+		}
+		if seen[term.Duration] {
+			return fmt.Errorf("duplicate lockup duration: %s", term.Duration)
+		}
+		seen[term.Duration] = true
+
+		if term.Multiplier.IsNil() || term.Multiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("lockup multiplier must be at least 1: %s", term.Multiplier)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/DFWallet/anatha/types"
+	"time"
+)
+
+// SavingsLockupTerm is a governance defined lockup period and the weight applied to the stake locked for it
+type SavingsLockupTerm struct {
+	Duration   time.Duration `json:"duration" yaml:"duration"`
+	Multiplier sdk.Dec       `json:"multiplier" yaml:"multiplier"`
+}
+
+func NewSavingsLockupTerm(duration time.Duration, multiplier sdk.Dec) SavingsLockupTerm {
+	return SavingsLockupTerm{
+		Duration:   duration,
+		Multiplier: multiplier,
+	}
+}
+
+func (t SavingsLockupTerm) String() string {
+	return fmt.Sprintf("%s x%s", t.Duration, t.Multiplier)
+}
+
+// SavingsLockup is the term chosen by a depositor, the multiplier is fixed at deposit time
+type SavingsLockup struct {
+	Term       time.Duration `json:"term" yaml:"term"`
+	Multiplier sdk.Dec       `json:"multiplier" yaml:"multiplier"`
+	UnlockTime time.Time     `json:"unlock_time" yaml:"unlock_time"`
+}
+
+func NewSavingsLockup(term SavingsLockupTerm, now time.Time) SavingsLockup {
+	return SavingsLockup{
+		Term:       term.Duration,
+		Multiplier: term.Multiplier,
+		UnlockTime: now.Add(term.Duration),
+	}
+}
+
+func (l SavingsLockup) IsLocked(now time.Time) bool {
+	return now.Before(l.UnlockTime)
+}
+
+func (l SavingsLockup) String() string {
+	return fmt.Sprintf(`Term: %s
+Multiplier: %s
+Unlock Time: %s`, l.Term, l.Multiplier, l.UnlockTime)
+}
+
+const (
+	// MaxSavingsCompoundsPerBlock bounds the auto compound sweep done in the BeginBlocker
+	MaxSavingsCompoundsPerBlock = 100
+
+	// MaxSavingsLockupExpiriesPerBlock bounds the lockup expiry sweep done in the BeginBlocker
+	MaxSavingsLockupExpiriesPerBlock = 100
+
+	// SavingsApyCompoundingPeriods is the number of compounding periods per year assumed by the APY projection
+	SavingsApyCompoundingPeriods = 365
+)
+
+// MaxProjectedSavingsApr caps the APR compounded by ProjectSavingsApy, (1 + apr / 365) ^ 365 overflows sdk.Dec not far above it
+var MaxProjectedSavingsApr = sdk.NewDec(100)
+
+// ProjectSavingsApy compounds the APR over SavingsApyCompoundingPeriods, APRs above MaxProjectedSavingsApr are projected at the cap
+func ProjectSavingsApy(apr sdk.Dec) sdk.Dec {
+	if apr.GT(MaxProjectedSavingsApr) {
+		apr = MaxProjectedSavingsApr
+	}
+
+	periodRate := apr.QuoInt64(SavingsApyCompoundingPeriods)
+
+	return sdk.OneDec().Add(periodRate).Power(SavingsApyCompoundingPeriods).Sub(sdk.OneDec())
+}
+
+type SavingsTermApy struct {
+	Duration   time.Duration `json:"duration" yaml:"duration"`
+	Multiplier sdk.Dec       `json:"multiplier" yaml:"multiplier"`
+	Apr        sdk.Dec       `json:"apr" yaml:"apr"`
+	Apy        sdk.Dec       `json:"apy" yaml:"apy"`
+}
+
+type QueryResSavingsApy struct {
+	Since time.Time        `json:"since" yaml:"since"`
+	Apr   sdk.Dec          `json:"apr" yaml:"apr"`
+	Apy   sdk.Dec          `json:"apy" yaml:"apy"`
+	Terms []SavingsTermApy `json:"terms" yaml:"terms"`
+}
+
+func (r QueryResSavingsApy) String() string {
+	out := fmt.Sprintf(`Since: %s
+APR: %s
+APY: %s`, r.Since, r.Apr, r.Apy)
+
+	for _, term := range r.Terms {
+		out += fmt.Sprintf("\nLockup %s (x%s): APR %s, APY %s", term.Duration, term.Multiplier, term.Apr, term.Apy)
+	}
+
+	return out
+}
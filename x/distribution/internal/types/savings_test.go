@@ -0,0 +1,19 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectSavingsApyCapsApr(t *testing.T) {
+	capped := ProjectSavingsApy(MaxProjectedSavingsApr)
+
+	require.NotPanics(t, func() {
+		require.Equal(t, capped, ProjectSavingsApy(sdk.NewDec(1000000)))
+	})
+
+	require.True(t, ProjectSavingsApy(sdk.NewDecWithPrec(1, 1)).GT(sdk.NewDecWithPrec(1, 1)))
+	require.True(t, ProjectSavingsApy(sdk.ZeroDec()).IsZero())
+}
@@ -65,6 +65,10 @@ func (d FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next
 		case hra.MsgRenewName:
 			msgFee = msgFee.Add(d.hraKeeper.NameInfoRenewalFee(ctx)...)
 
+			if d.hraKeeper.IsNameInGracePeriod(ctx, msg.Name) {
+				msgFee = msgFee.Add(d.hraKeeper.NameInfoRedemptionFee(ctx)...)
+			}
+
 		case hra.MsgBuyName:
 			fee, err := d.hraKeeper.GetPrice(ctx, msg.Name)
 			if err != nil {
@@ -78,21 +82,36 @@ func (d FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next
 
 			msgFee = msgFee.Add(fee...)
 
-		case hra.MsgRegisterAddress:
+		case hra.MsgPlaceBid:
+			msgFee = msgFee.Add(msg.Amount...)
+
+		case hra.MsgRegisterAddress, hra.MsgRegisterAddressV2, hra.MsgSetTextRecord, hra.MsgRegisterNameAddress:
 			if credits.LTE(sdk.ZeroInt()) {
 				msgFee = msgFee.Add(d.hraKeeper.AddressRegistrationFee(ctx)...)
 			}
 
 			credits = credits.Sub(sdk.OneInt())
 
+		case hra.MsgSetAddresses:
+			// every record in the list is charged as a separate address registration
+			for range msg.Addresses {
+				if credits.LTE(sdk.ZeroInt()) {
+					msgFee = msgFee.Add(d.hraKeeper.AddressRegistrationFee(ctx)...)
+				}
+
+				credits = credits.Sub(sdk.OneInt())
+			}
+
 		case hra.MsgTransferName:
-			if namesOwnedCount == 1 {
+			// operators act on names of other accounts, their own names are not affected
+			if namesOwnedCount == 1 && d.hraKeeper.IsNameOwner(ctx, msg.Name, feePayer) {
 				namesOwnedCount--
 				credits = sdk.ZeroInt()
 			}
 
 		case hra.MsgDeleteName:
-			if namesOwnedCount == 1 {
+			// operators act on names of other accounts, their own names are not affected
+			if namesOwnedCount == 1 && d.hraKeeper.IsNameOwner(ctx, msg.Name, feePayer) {
 				namesOwnedCount--
 				credits = sdk.ZeroInt()
 			}
@@ -107,6 +126,11 @@ func (d FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next
 
 		case treasury.MsgCreateSellOrder:
 			msgFee = msgFee.Add(msg.Amount...)
+
+		case treasury.MsgCreateLimitOrder:
+			if msg.Side == treasury.OrderSideSell {
+				msgFee = msgFee.Add(msg.Amount...)
+			}
 		}
 
 		txFees = txFees.Add(msgFee...)
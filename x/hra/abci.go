@@ -10,12 +10,48 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 }
 
 func EndBlocker(ctx sdk.Context, k Keeper) {
+	// auctions end no later than the name expiry, so they are settled first
+	k.IterateEndedAuctionQueue(ctx, ctx.BlockTime(), func(name string) (stop bool) {
+		auction, found := k.GetAuction(ctx, name)
+		if ! found {
+			panic(fmt.Sprintf("auction %s does not exist", name))
+		}
+
+		err := k.SettleAuction(ctx, auction)
+		if err != nil {
+			panic(fmt.Sprintf("error settling auction %s: %s", name, err))
+		}
+
+		return false
+	})
+
 	k.IterateExpiredNameInfoQueue(ctx, ctx.BlockTime(), func(name string) (stop bool) {
 		nameInfo, found := k.GetNameInfo(ctx, name)
 		if ! found {
 			panic(fmt.Sprintf("name info %s does not exist", name))
 		}
 
+		// expired names are frozen for the grace period before being deleted
+		if k.NameInfoGracePeriod(ctx) > 0 {
+			k.StartGracePeriod(ctx, nameInfo)
+
+			return false
+		}
+
+		err := k.DeleteExpiredNameInfo(ctx, nameInfo)
+		if err != nil {
+			panic("error deleting expired name info")
+		}
+
+		return false
+	})
+
+	k.IterateGracePeriodQueue(ctx, ctx.BlockTime(), func(name string) (stop bool) {
+		nameInfo, found := k.GetNameInfo(ctx, name)
+		if ! found {
+			panic(fmt.Sprintf("name info %s does not exist", name))
+		}
+
 		err := k.DeleteExpiredNameInfo(ctx, nameInfo)
 		if err != nil {
 			panic("error deleting expired name info")
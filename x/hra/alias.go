@@ -12,6 +12,7 @@ const (
 	DefaultParamspace = types.DefaultParamspace
 	QuerierRoute      = types.QuerierRoute
 	NameConstraintBlock = types.NameConstraintBlock
+	AuctionEscrowModuleName = types.AuctionEscrowModuleName
 )
 
 var (
@@ -29,10 +30,27 @@ var (
 	NewMsgBuyName		= types.NewMsgBuyName
 	NewMsgTransferName	= types.NewMsgTransferName
 	NewMsgRegisterAddress = types.NewMsgRegisterAddress
+	NewMsgRegisterAddressV2 = types.NewMsgRegisterAddressV2
+	NewMsgSetAddresses = types.NewMsgSetAddresses
 	NewMsgRemoveAddress = types.NewMsgRemoveAddress
+	NewMsgCreateAuction = types.NewMsgCreateAuction
+	NewMsgPlaceBid      = types.NewMsgPlaceBid
+	NewMsgCancelAuction = types.NewMsgCancelAuction
+	NewMsgCreateSubname = types.NewMsgCreateSubname
+	NewMsgRevokeSubname = types.NewMsgRevokeSubname
+	NewMsgSetPrimaryName = types.NewMsgSetPrimaryName
+	NewMsgSetTextRecord = types.NewMsgSetTextRecord
+	NewMsgRemoveTextRecord = types.NewMsgRemoveTextRecord
+	NewMsgRegisterNameAddress = types.NewMsgRegisterNameAddress
+	NewMsgRemoveNameAddress = types.NewMsgRemoveNameAddress
+	NewMsgApprove = types.NewMsgApprove
+	NewMsgRevokeApproval = types.NewMsgRevokeApproval
+	NewMsgSetApprovalForAll = types.NewMsgSetApprovalForAll
 
 	ModuleCdc     = types.ModuleCdc
 
+	RegisterAddressValidator = types.RegisterAddressValidator
+
 	KeyNameInfoDuration = types.KeyNameInfoDuration
 
 	ErrNameNotRegistered = types.ErrNameNotRegistered
@@ -44,6 +62,7 @@ type (
 	Params       = types.Params
 	MultiNameHooks = types.MultiNameHooks
 	NameHooks = types.NameHooks
+	AddressValidator = types.AddressValidator
 
 	MsgRegisterName = types.MsgRegisterName
 	MsgRenewName	= types.MsgRenewName
@@ -52,6 +71,21 @@ type (
 	MsgBuyName 		= types.MsgBuyName
 	MsgTransferName = types.MsgTransferName
 	MsgRegisterAddress = types.MsgRegisterAddress
+	MsgRegisterAddressV2 = types.MsgRegisterAddressV2
+	MsgSetAddresses = types.MsgSetAddresses
 	MsgRemoveAddress = types.MsgRemoveAddress
 	MsgRemoveAllAddresses = types.MsgRemoveAllAddresses
+	MsgCreateAuction = types.MsgCreateAuction
+	MsgPlaceBid = types.MsgPlaceBid
+	MsgCancelAuction = types.MsgCancelAuction
+	MsgCreateSubname = types.MsgCreateSubname
+	MsgRevokeSubname = types.MsgRevokeSubname
+	MsgSetPrimaryName = types.MsgSetPrimaryName
+	MsgSetTextRecord = types.MsgSetTextRecord
+	MsgRemoveTextRecord = types.MsgRemoveTextRecord
+	MsgRegisterNameAddress = types.MsgRegisterNameAddress
+	MsgRemoveNameAddress = types.MsgRemoveNameAddress
+	MsgApprove = types.MsgApprove
+	MsgRevokeApproval = types.MsgRevokeApproval
+	MsgSetApprovalForAll = types.MsgSetApprovalForAll
 )
@@ -31,7 +31,7 @@ func GetCmdSubmitRegisterBlockchainIdProposal(cdc *codec.Codec) *cobra.Command {
 
 			from := cliCtx.GetFromAddress()
 
-			content := types.NewRegisterBlockchainIdProposal(proposal.Title, proposal.Description, proposal.BlockchainId)
+			content := types.NewRegisterBlockchainIdProposal(proposal.Title, proposal.Description, proposal.BlockchainId, proposal.AddressValidator)
 
 			msg := govtypes.NewMsgSubmitProposal(content, from)
 			if err := msg.ValidateBasic(); err != nil {
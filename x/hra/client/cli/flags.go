@@ -0,0 +1,9 @@
+package cli
+
+const (
+	FlagLabel   = "label"
+	FlagMemo    = "memo"
+	FlagDefault = "default"
+	FlagReplace = "replace"
+	FlagCanTransfer = "can-transfer"
+)
@@ -32,6 +32,14 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdGetAddressCredits(queryRoute, cdc),
 			GetCmdGetBlockchainAddresses(queryRoute, cdc),
 			GetCmdQueryParams(queryRoute, cdc),
+			GetCmdGetAuction(queryRoute, cdc),
+			GetCmdGetAuctions(queryRoute, cdc),
+			GetCmdGetNamesInGracePeriod(queryRoute, cdc),
+			GetCmdGetSubname(queryRoute, cdc),
+			GetCmdGetSubnames(queryRoute, cdc),
+			GetCmdReverse(queryRoute, cdc),
+			GetCmdGetBlockchainIdValidators(queryRoute, cdc),
+			GetCmdApprovals(queryRoute, cdc),
 		)...,
 	)
 
@@ -96,14 +104,15 @@ func GetCmdGetBlockchainAddress(queryRoute string, cdc *codec.Codec) *cobra.Comm
 			name := args[0]
 			blockchainId := args[1]
 
-			index := "0"
+			// without an index the default address of the blockchain id is resolved
+			route := fmt.Sprintf("custom/%s/address/%s/%s", queryRoute, name, blockchainId)
 			if len(args) == 3 {
-				index = args[2]
+				route = fmt.Sprintf("%s/%s", route, args[2])
 			}
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/address/%s/%s/%s", queryRoute, name, blockchainId, index), nil)
+			res, _, err := cliCtx.QueryWithData(route, nil)
 			if err != nil {
-				fmt.Printf("Could not resolve address - %s/%s/%s \n", name, blockchainId, index)
+				fmt.Printf("Could not resolve address - %s/%s \n", name, blockchainId)
 				return nil
 			}
 
@@ -201,6 +210,162 @@ func GetCmdGetBlockchainAddresses(queryRoute string, cdc *codec.Codec) *cobra.Co
 	}
 }
 
+func GetCmdGetAuction(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auction [name]",
+		Short: "Query the open auction of a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/auction/%s", queryRoute, name), nil)
+			if err != nil {
+				fmt.Printf("Could not find auction - %s \n", name)
+				return nil
+			}
+
+			var out types.Auction
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdGetAuctions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auctions",
+		Short: "Query all open auctions",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/auctions", queryRoute), nil)
+			if err != nil {
+				fmt.Print("Could not get auctions \n")
+				return nil
+			}
+
+			var out types.QueryResAuctions
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdGetNamesInGracePeriod(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grace-period [address]",
+		Short: "Query expired names in the grace period, optionally filtered by owner address",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/grace-period", queryRoute)
+			if len(args) == 1 {
+				route = fmt.Sprintf("%s/%s", route, args[0])
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Print("Could not get names in grace period \n")
+				return nil
+			}
+
+			var out types.QueryResNameInfoGracePeriods
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdGetSubname(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "subname [name]",
+		Short: "Query subname info",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/subname/%s", queryRoute, name), nil)
+			if err != nil {
+				fmt.Printf("Could not find subname - %s \n", name)
+				return nil
+			}
+
+			var out types.QueryResSubname
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdGetSubnames(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "subnames [parent]",
+		Short: "Query all subnames of a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			parent := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/subnames/%s", queryRoute, parent), nil)
+			if err != nil {
+				fmt.Printf("Could not get subnames - %s \n", parent)
+				return nil
+			}
+
+			var out types.QueryResSubnames
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdReverse(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reverse [address]",
+		Short: "Query the primary name of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			address := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/reverse/%s", queryRoute, address), nil)
+			if err != nil {
+				fmt.Printf("Could not find primary name - %s \n", address)
+				return nil
+			}
+
+			var out types.PrimaryNameInfo
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdGetBlockchainIdValidators(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "blockchain-id-validators",
+		Short: "Query the address validators assigned to registered blockchain ids",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/blockchain-id-validators", queryRoute), nil)
+			if err != nil {
+				fmt.Print("Could not get blockchain id validators \n")
+				return nil
+			}
+
+			var out types.QueryResBlockchainIdValidators
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 func GetModuleAccountCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "module [name]",
@@ -247,4 +412,26 @@ func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return cliCtx.PrintOutput(params)
 		},
 	}
-}
\ No newline at end of file
+}
+
+func GetCmdApprovals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "approvals [address]",
+		Short: "Query the operators approved by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			address := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/approvals/%s", queryRoute, address), nil)
+			if err != nil {
+				fmt.Printf("Could not find approvals - %s \n", address)
+				return nil
+			}
+
+			var out types.QueryResApprovals
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/DFWallet/anatha/x/auth/client/utils"
 	denom "github.com/DFWallet/project-anatha/utils"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"io/ioutil"
 	"os"
+	"strconv"
 
 	"github.com/DFWallet/anatha/client"
 	"github.com/DFWallet/anatha/codec"
@@ -38,8 +40,22 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdTransferName(cdc),
 		GetCmdRegisterAddress(cdc),
 		GetCmdRegisterAddressBatch(cdc),
+		GetCmdSetAddresses(cdc),
 		GetCmdRemoveAddress(cdc),
 		GetCmdRemoveAllAddresses(cdc),
+		GetCmdCreateAuction(cdc),
+		GetCmdPlaceBid(cdc),
+		GetCmdCancelAuction(cdc),
+		GetCmdCreateSubname(cdc),
+		GetCmdRevokeSubname(cdc),
+		GetCmdSetPrimaryName(cdc),
+		GetCmdSetTextRecord(cdc),
+		GetCmdRemoveTextRecord(cdc),
+		GetCmdRegisterNameAddress(cdc),
+		GetCmdRemoveNameAddress(cdc),
+		GetCmdApprove(cdc),
+		GetCmdRevokeApproval(cdc),
+		GetCmdSetApprovalForAll(cdc),
 	)...)
 
 	return hraTxCmd
@@ -86,11 +102,14 @@ func GetCmdRegisterName(cdc *codec.Codec) *cobra.Command {
 			msgs = append(msgs, msg)
 
 			for _, address := range addresses {
-				registerAddressMsg := types.NewMsgRegisterAddress(
+				registerAddressMsg := types.NewMsgRegisterAddressV2(
 					cliCtx.GetFromAddress(),
 					address.BlockchainId,
 					address.Index,
 					address.BlockchainAddress,
+					address.Label,
+					address.Memo,
+					address.Default,
 				)
 
 				err := registerAddressMsg.ValidateBasic()
@@ -234,7 +253,7 @@ func GetCmdTransferName(cdc *codec.Codec) *cobra.Command {
 }
 
 func GetCmdRegisterAddress(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "register-address [blockchanId] [index] [blockchainAddress]",
 		Short: "register a new blockchain address",
 		Args:  cobra.ExactArgs(3),
@@ -244,7 +263,15 @@ func GetCmdRegisterAddress(cdc *codec.Codec) *cobra.Command {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 
-			msg := types.NewMsgRegisterAddress(cliCtx.GetFromAddress(), args[0], args[1], args[2])
+			msg := types.NewMsgRegisterAddressV2(
+				cliCtx.GetFromAddress(),
+				args[0],
+				args[1],
+				args[2],
+				viper.GetString(FlagLabel),
+				viper.GetString(FlagMemo),
+				viper.GetBool(FlagDefault),
+			)
 			err := msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -253,6 +280,12 @@ func GetCmdRegisterAddress(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().String(FlagLabel, "", "Human readable label of the address")
+	cmd.Flags().String(FlagMemo, "", "Destination tag or memo required by the address")
+	cmd.Flags().Bool(FlagDefault, false, "Prefer this index when resolving the blockchain id")
+
+	return cmd
 }
 
 func GetCmdRegisterAddressBatch(cdc *codec.Codec) *cobra.Command {
@@ -284,11 +317,14 @@ func GetCmdRegisterAddressBatch(cdc *codec.Codec) *cobra.Command {
 			}
 
 			for _, address := range addresses {
-				registerAddressMsg := types.NewMsgRegisterAddress(
+				registerAddressMsg := types.NewMsgRegisterAddressV2(
 					cliCtx.GetFromAddress(),
 					address.BlockchainId,
 					address.Index,
 					address.BlockchainAddress,
+					address.Label,
+					address.Memo,
+					address.Default,
 				)
 
 				err := registerAddressMsg.ValidateBasic()
@@ -304,6 +340,48 @@ func GetCmdRegisterAddressBatch(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdSetAddresses(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-addresses [addresses-file]",
+		Short: "set a list of blockchain addresses in a single message",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			var addresses []types.BlockchainAddressInfo
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+
+			defer closeFile(file)
+
+			bytes, _ := ioutil.ReadAll(file)
+
+			err = json.Unmarshal(bytes, &addresses)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAddresses(cliCtx.GetFromAddress(), addresses, viper.GetBool(FlagReplace))
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(FlagReplace, false, "Remove all existing addresses before setting the new ones")
+
+	return cmd
+}
+
 func GetCmdRemoveAddress(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "remove-address [blockchanId] [index]",
@@ -346,4 +424,346 @@ func GetCmdRemoveAllAddresses(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
-}
\ No newline at end of file
+}
+
+func GetCmdCreateAuction(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-auction [name] [reserve-price] [end-time]",
+		Short: "open an auction for a hra",
+		Long:  "Open an english auction for a hra. End time has to be provided in the 2006-01-02T15:04:05Z format.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			reservePrice, err := denom.ParseAndConvertCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateAuction(args[0], cliCtx.GetFromAddress(), reservePrice, args[2])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdPlaceBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bid [name] [amount]",
+		Short: "bid on a hra auction",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := denom.ParseAndConvertCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBid(args[0], cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCancelAuction(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-auction [name]",
+		Short: "cancel a hra auction without bids",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgCancelAuction(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCreateSubname(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-subname [name] [owner]",
+		Short: "create a subname of an owned hra and assign it to an account",
+		Long:  "Create a subname in the label.parent format (e.g. alice.team) and assign it to an account. Creating an existing subname reassigns it.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			subnameOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSubname(args[0], cliCtx.GetFromAddress(), subnameOwner)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRevokeSubname(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-subname [name]",
+		Short: "revoke a subname of an owned hra",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRevokeSubname(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdSetPrimaryName(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-primary-name [name]",
+		Short: "set an owned hra as the primary name of your account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgSetPrimaryName(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdSetTextRecord(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-text-record [name] [key] [value]",
+		Short: "set a text record of an owned hra",
+		Long:  "Set a profile text record of an owned hra, e.g. avatar, email, url or pubkey. Charged as an address registration.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgSetTextRecord(args[0], cliCtx.GetFromAddress(), args[1], args[2])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRemoveTextRecord(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-text-record [name] [key]",
+		Short: "remove a text record of an owned hra",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRemoveTextRecord(args[0], cliCtx.GetFromAddress(), args[1])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRegisterNameAddress(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-name-address [name] [blockchanId] [index] [blockchainAddress]",
+		Short: "register a blockchain address on an owned hra, overriding the account addresses for that hra",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRegisterNameAddress(
+				args[0],
+				cliCtx.GetFromAddress(),
+				args[1],
+				args[2],
+				args[3],
+				viper.GetString(FlagLabel),
+				viper.GetString(FlagMemo),
+				viper.GetBool(FlagDefault),
+			)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagLabel, "", "Human readable label of the address")
+	cmd.Flags().String(FlagMemo, "", "Destination tag or memo required by the address")
+	cmd.Flags().Bool(FlagDefault, false, "Prefer this index when resolving the blockchain id")
+
+	return cmd
+}
+
+func GetCmdRemoveNameAddress(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-name-address [name] [blockchanId] [index]",
+		Short: "remove a blockchain address of an owned hra",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRemoveNameAddress(args[0], cliCtx.GetFromAddress(), args[1], args[2])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdApprove(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [name] [operator]",
+		Short: "approve an operator to manage the records and renewals of an owned hra",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			operator, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApprove(args[0], cliCtx.GetFromAddress(), operator, viper.GetBool(FlagCanTransfer))
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(FlagCanTransfer, false, "Allow the operator to transfer, sell and delete the hra")
+
+	return cmd
+}
+
+func GetCmdRevokeApproval(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-approval [name]",
+		Short: "revoke the operator of an owned hra",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRevokeApproval(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdSetApprovalForAll(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-approval-for-all [operator] [approved]",
+		Short: "approve or revoke an operator for all owned hras",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			operator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			approved, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetApprovalForAll(cliCtx.GetFromAddress(), operator, approved, viper.GetBool(FlagCanTransfer))
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(FlagCanTransfer, false, "Allow the operator to transfer, sell and delete the hras")
+
+	return cmd
+}
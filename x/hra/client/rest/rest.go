@@ -10,6 +10,7 @@ import (
 
 const (
 	restName = "name"
+	restAddress = "address"
 )
 
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
@@ -22,5 +23,26 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/transfer", storeName, restName), transferNameHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/addresses", storeName), registerAddressHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/addresses", storeName), removeAddressHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/addresses", storeName), setAddressesHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/auctions", storeName), queryAuctionsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/grace-period", storeName), queryNamesInGracePeriodHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/auctions/{%s}", storeName, restName), queryAuctionHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/auctions/{%s}", storeName, restName), createAuctionHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/auctions/{%s}", storeName, restName), cancelAuctionHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/auctions/{%s}/bid", storeName, restName), placeBidHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/subnames", storeName, restName), querySubnamesHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/subnames", storeName), createSubnameHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/subnames", storeName), revokeSubnameHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/subnames/{%s}", storeName, restName), querySubnameHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/primary", storeName, restName), setPrimaryNameHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/records", storeName, restName), setTextRecordHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/records", storeName, restName), removeTextRecordHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/addresses", storeName, restName), registerNameAddressHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/addresses", storeName, restName), removeNameAddressHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/approval", storeName, restName), approveHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/names/{%s}/approval", storeName, restName), revokeApprovalHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/operators", storeName), setApprovalForAllHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/approvals/{%s}", storeName, restAddress), queryApprovalsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/reverse/{%s}", storeName, restAddress), queryReverseHandler(cliCtx, storeName)).Methods("GET")
 }
 
@@ -249,6 +249,9 @@ type registerAddressHandlerReq struct {
 	BlockchainId string		`json:"blockchain_id" yaml:"blockchain_id"`
 	Index string			`json:"index" yaml:"index"`
 	BlockchainAddress string`json:"blockchain_address" yaml:"blockchain_address"`
+	Label string			`json:"label" yaml:"label"`
+	Memo string				`json:"memo" yaml:"memo"`
+	Default bool			`json:"default" yaml:"default"`
 }
 func registerAddressHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -270,7 +273,44 @@ func registerAddressHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// create the message
-		msg := types.NewMsgRegisterAddress(owner, req.BlockchainId, req.Index, req.BlockchainAddress)
+		msg := types.NewMsgRegisterAddressV2(owner, req.BlockchainId, req.Index, req.BlockchainAddress, req.Label, req.Memo, req.Default)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setAddressesHandlerReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Owner   string       `json:"owner" yaml:"owner"`
+	Addresses []types.BlockchainAddressInfo	`json:"addresses" yaml:"addresses"`
+	Replace bool			`json:"replace" yaml:"replace"`
+}
+func setAddressesHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setAddressesHandlerReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetAddresses(owner, req.Addresses, req.Replace)
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -316,4 +356,531 @@ func removeAddressHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
-}
\ No newline at end of file
+}
+
+type createAuctionReq struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name         string       `json:"name" yaml:"name"`
+	Owner        string       `json:"owner" yaml:"owner"`
+	ReservePrice string       `json:"reserve_price" yaml:"reserve_price"`
+	EndTime      string       `json:"end_time" yaml:"end_time"`
+}
+func createAuctionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createAuctionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		reservePrice, err := denom.ParseAndConvertCoins(req.ReservePrice)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCreateAuction(req.Name, addr, reservePrice, req.EndTime)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type placeBidReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Bidder  string       `json:"bidder" yaml:"bidder"`
+	Amount  string       `json:"amount" yaml:"amount"`
+}
+func placeBidHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req placeBidReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Bidder)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		amount, err := denom.ParseAndConvertCoins(req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgPlaceBid(req.Name, addr, amount)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type cancelAuctionReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+}
+func cancelAuctionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req cancelAuctionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCancelAuction(req.Name, addr)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type createSubnameReq struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name         string       `json:"name" yaml:"name"`
+	Owner        string       `json:"owner" yaml:"owner"`
+	SubnameOwner string       `json:"subname_owner" yaml:"subname_owner"`
+}
+
+func createSubnameHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createSubnameReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		subnameOwner, err := sdk.AccAddressFromBech32(req.SubnameOwner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCreateSubname(req.Name, addr, subnameOwner)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type revokeSubnameReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+}
+
+func revokeSubnameHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revokeSubnameReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRevokeSubname(req.Name, addr)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setPrimaryNameReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+}
+
+func setPrimaryNameHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setPrimaryNameReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetPrimaryName(req.Name, addr)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTextRecordReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+	Key     string       `json:"key" yaml:"key"`
+	Value   string       `json:"value" yaml:"value"`
+}
+
+func setTextRecordHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setTextRecordReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetTextRecord(req.Name, addr, req.Key, req.Value)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type removeTextRecordReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+	Key     string       `json:"key" yaml:"key"`
+}
+
+func removeTextRecordHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req removeTextRecordReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRemoveTextRecord(req.Name, addr, req.Key)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type registerNameAddressReq struct {
+	BaseReq           rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name              string       `json:"name" yaml:"name"`
+	Owner             string       `json:"owner" yaml:"owner"`
+	BlockchainId      string       `json:"blockchain_id" yaml:"blockchain_id"`
+	Index             string       `json:"index" yaml:"index"`
+	BlockchainAddress string       `json:"blockchain_address" yaml:"blockchain_address"`
+	Label             string       `json:"label" yaml:"label"`
+	Memo              string       `json:"memo" yaml:"memo"`
+	Default           bool         `json:"default" yaml:"default"`
+}
+
+func registerNameAddressHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req registerNameAddressReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRegisterNameAddress(req.Name, addr, req.BlockchainId, req.Index, req.BlockchainAddress, req.Label, req.Memo, req.Default)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type removeNameAddressReq struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name         string       `json:"name" yaml:"name"`
+	Owner        string       `json:"owner" yaml:"owner"`
+	BlockchainId string       `json:"blockchain_id" yaml:"blockchain_id"`
+	Index        string       `json:"index" yaml:"index"`
+}
+
+func removeNameAddressHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req removeNameAddressReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRemoveNameAddress(req.Name, addr, req.BlockchainId, req.Index)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type approveReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name        string       `json:"name" yaml:"name"`
+	Owner       string       `json:"owner" yaml:"owner"`
+	Operator    string       `json:"operator" yaml:"operator"`
+	CanTransfer bool         `json:"can_transfer" yaml:"can_transfer"`
+}
+
+func approveHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req approveReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		operator, err := sdk.AccAddressFromBech32(req.Operator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgApprove(req.Name, addr, operator, req.CanTransfer)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type revokeApprovalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Name    string       `json:"name" yaml:"name"`
+	Owner   string       `json:"owner" yaml:"owner"`
+}
+
+func revokeApprovalHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revokeApprovalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRevokeApproval(req.Name, addr)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setApprovalForAllReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Owner       string       `json:"owner" yaml:"owner"`
+	Operator    string       `json:"operator" yaml:"operator"`
+	Approved    bool         `json:"approved" yaml:"approved"`
+	CanTransfer bool         `json:"can_transfer" yaml:"can_transfer"`
+}
+
+func setApprovalForAllHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setApprovalForAllReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		operator, err := sdk.AccAddressFromBech32(req.Operator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetApprovalForAll(addr, operator, req.Approved, req.CanTransfer)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
@@ -10,6 +10,7 @@ type BlockchainIdProposalJSON struct {
 	Title 			string `json:"title" yaml:"title"`
 	Description 	string `json:"description" yaml:"description"`
 	BlockchainId 	string `json:"blockchain_id" yaml:"blockchain_id"`
+	AddressValidator string `json:"address_validator" yaml:"address_validator"`
 }
 
 func ParseBlockchainIdProposalJSON(cdc *codec.Codec, proposalFile string) (BlockchainIdProposalJSON, error) {
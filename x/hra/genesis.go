@@ -25,6 +25,41 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.Valid
 
 	for _, record := range data.AddressRecords {
 		keeper.SetAddress(ctx, record.Address, record.BlockchainAddressInfo.BlockchainId, record.BlockchainAddressInfo.Index, record.BlockchainAddressInfo.BlockchainAddress)
+		keeper.SetAddressMetadata(ctx, record.Address, record.BlockchainAddressInfo.BlockchainId, record.BlockchainAddressInfo.Index, record.BlockchainAddressInfo.Metadata())
+	}
+
+	for _, record := range data.Auctions {
+		keeper.SetAuction(ctx, record)
+
+		keeper.InsertAuctionQueue(ctx, record.Name, record.EndTime)
+	}
+
+	for _, record := range data.Subnames {
+		keeper.SetSubname(ctx, record)
+	}
+
+	for _, record := range data.PrimaryNames {
+		keeper.SetPrimaryName(ctx, record.Address, record.Name)
+	}
+
+	for _, record := range data.BlockchainIdValidators {
+		keeper.SetBlockchainIdValidator(ctx, record.BlockchainId, record.AddressValidator)
+	}
+
+	for _, record := range data.TextRecords {
+		keeper.SetTextRecord(ctx, record.Name, record.TextRecord)
+	}
+
+	for _, record := range data.NameAddressRecords {
+		keeper.SetNameAddress(ctx, record.Name, record.BlockchainAddressInfo)
+	}
+
+	for _, record := range data.NameApprovals {
+		keeper.SetNameApproval(ctx, record)
+	}
+
+	for _, record := range data.OperatorApprovals {
+		keeper.SetOperatorApproval(ctx, record)
 	}
 
 	return []abci.ValidatorUpdate{}
@@ -58,11 +93,54 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		return false
 	})
 
+	var primaryNames []types.PrimaryNameInfo
+	k.IteratePrimaryNames(ctx, func (primaryName types.PrimaryNameInfo) (stop bool) {
+		primaryNames = append(primaryNames, primaryName)
+
+		return false
+	})
+
+	var textRecords []types.NameTextRecord
+	k.IterateAllTextRecords(ctx, func (record types.NameTextRecord) (stop bool) {
+		textRecords = append(textRecords, record)
+
+		return false
+	})
+
+	var nameAddressRecords []types.NameBlockchainAddressInfo
+	k.IterateAllNameAddresses(ctx, func (record types.NameBlockchainAddressInfo) (stop bool) {
+		nameAddressRecords = append(nameAddressRecords, record)
+
+		return false
+	})
+
+	var nameApprovals []types.Approval
+	k.IterateNameApprovals(ctx, func (approval types.Approval) (stop bool) {
+		nameApprovals = append(nameApprovals, approval)
+
+		return false
+	})
+
+	var operatorApprovals []types.Approval
+	k.IterateOperatorApprovals(ctx, func (approval types.Approval) (stop bool) {
+		operatorApprovals = append(operatorApprovals, approval)
+
+		return false
+	})
+
 	return GenesisState{
 		Params:      params,
 		NameRecords: nameInfos,
 		AddressCredits: addressCredits,
 		AddressRecords: addressRecords,
 		RegisteredBlockchainIds: k.GetRegisteredBlockchainIds(ctx),
+		Auctions: k.GetAuctions(ctx),
+		Subnames: k.GetSubnames(ctx),
+		PrimaryNames: primaryNames,
+		BlockchainIdValidators: k.GetBlockchainIdValidators(ctx),
+		TextRecords: textRecords,
+		NameAddressRecords: nameAddressRecords,
+		NameApprovals: nameApprovals,
+		OperatorApprovals: operatorApprovals,
 	}
 }
\ No newline at end of file
@@ -5,6 +5,9 @@ import (
 	sdkerrors "github.com/DFWallet/anatha/types/errors"
 	govtypes "github.com/DFWallet/anatha/x/gov/types"
 	"github.com/DFWallet/project-anatha/x/hra/internal/types"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func NewHandler(k Keeper) sdk.Handler {
@@ -25,10 +28,40 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgTransferName(ctx, msg, k)
 		case MsgRegisterAddress:
 			return handleMsgRegisterAddress(ctx, msg, k)
+		case MsgRegisterAddressV2:
+			return handleMsgRegisterAddressV2(ctx, msg, k)
+		case MsgSetAddresses:
+			return handleMsgSetAddresses(ctx, msg, k)
 		case MsgRemoveAddress:
 			return handleMsgRemoveAddress(ctx, msg, k)
 		case MsgRemoveAllAddresses:
 			return handleMsgRemoveAllAddresses(ctx, msg, k)
+		case MsgCreateAuction:
+			return handleMsgCreateAuction(ctx, msg, k)
+		case MsgPlaceBid:
+			return handleMsgPlaceBid(ctx, msg, k)
+		case MsgCancelAuction:
+			return handleMsgCancelAuction(ctx, msg, k)
+		case MsgCreateSubname:
+			return handleMsgCreateSubname(ctx, msg, k)
+		case MsgRevokeSubname:
+			return handleMsgRevokeSubname(ctx, msg, k)
+		case MsgSetPrimaryName:
+			return handleMsgSetPrimaryName(ctx, msg, k)
+		case MsgSetTextRecord:
+			return handleMsgSetTextRecord(ctx, msg, k)
+		case MsgRemoveTextRecord:
+			return handleMsgRemoveTextRecord(ctx, msg, k)
+		case MsgRegisterNameAddress:
+			return handleMsgRegisterNameAddress(ctx, msg, k)
+		case MsgRemoveNameAddress:
+			return handleMsgRemoveNameAddress(ctx, msg, k)
+		case MsgApprove:
+			return handleMsgApprove(ctx, msg, k)
+		case MsgRevokeApproval:
+			return handleMsgRevokeApproval(ctx, msg, k)
+		case MsgSetApprovalForAll:
+			return handleMsgSetApprovalForAll(ctx, msg, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -53,12 +86,22 @@ func NewGovernanceProposalHandler(k Keeper) govtypes.Handler {
 func handleProposalRegisterBlockchainId(ctx sdk.Context, k Keeper, proposal types.RegisterBlockchainIdProposal) error {
 	k.SetRegisteredBlockchainId(ctx, proposal.BlockchainId)
 
+	if proposal.AddressValidator != "" {
+		k.SetBlockchainIdValidator(ctx, proposal.BlockchainId, proposal.AddressValidator)
+	}
+
+	addressValidator, found := k.GetBlockchainIdValidator(ctx, strings.ToLower(proposal.BlockchainId))
+	if ! found {
+		addressValidator = types.AddressValidatorGeneric
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeRegisterBlockchainId,
 			sdk.NewAttribute(types.AttributeKeyTitle, proposal.Title),
 			sdk.NewAttribute(types.AttributeKeyDescription, proposal.Description),
 			sdk.NewAttribute(types.AttributeKeyBlockchainId, proposal.BlockchainId),
+			sdk.NewAttribute(types.AttributeKeyAddressValidator, addressValidator),
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
 		),
 	)
@@ -68,6 +111,7 @@ func handleProposalRegisterBlockchainId(ctx sdk.Context, k Keeper, proposal type
 
 func handleProposalRemoveBlockchainId(ctx sdk.Context, k Keeper, proposal types.RemoveBlockchainIdProposal) error {
 	k.RemoveRegisteredBlockchainId(ctx, proposal.BlockchainId)
+	k.RemoveBlockchainIdValidator(ctx, proposal.BlockchainId)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -192,7 +236,8 @@ func handleMsgTransferName(ctx sdk.Context, msg MsgTransferName, k Keeper) (*sdk
 }
 
 func handleMsgRegisterAddress(ctx sdk.Context, msg MsgRegisterAddress, k Keeper) (*sdk.Result, error) {
-	err := k.HandleRegisterAddress(ctx, msg.Owner, msg.BlockchainId, msg.Index, msg.BlockchainAddress)
+	// the legacy message does not carry metadata, registering over a record clears it
+	err := k.HandleRegisterAddress(ctx, msg.Owner, msg.BlockchainId, msg.Index, msg.BlockchainAddress, types.AddressMetadata{})
 
 	if err != nil {
 		return nil, err
@@ -215,6 +260,62 @@ func handleMsgRegisterAddress(ctx sdk.Context, msg MsgRegisterAddress, k Keeper)
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgRegisterAddressV2(ctx sdk.Context, msg MsgRegisterAddressV2, k Keeper) (*sdk.Result, error) {
+	err := k.HandleRegisterAddress(ctx, msg.Owner, msg.BlockchainId, msg.Index, msg.BlockchainAddress, msg.Metadata())
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterAddress,
+			sdk.NewAttribute(types.AttributeKeyBlockchainId, msg.BlockchainId),
+			sdk.NewAttribute(types.AttributeKeyIndex, msg.Index),
+			sdk.NewAttribute(types.AttributeKeyBlockchainAddress, msg.BlockchainAddress),
+			sdk.NewAttribute(types.AttributeKeyLabel, msg.Label),
+			sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
+			sdk.NewAttribute(types.AttributeKeyDefault, strconv.FormatBool(msg.Default)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetAddresses(ctx sdk.Context, msg MsgSetAddresses, k Keeper) (*sdk.Result, error) {
+	err := k.HandleSetAddresses(ctx, msg.Owner, msg.Addresses, msg.Replace)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var records []string
+	for _, address := range msg.Addresses {
+		records = append(records, address.BlockchainId + types.Separator + address.Index)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAddresses,
+			sdk.NewAttribute(types.AttributeKeyCount, strconv.Itoa(len(msg.Addresses))),
+			sdk.NewAttribute(types.AttributeKeyReplace, strconv.FormatBool(msg.Replace)),
+			sdk.NewAttribute(types.AttributeKeyAddresses, strings.Join(records, ",")),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgRemoveAddress(ctx sdk.Context, msg MsgRemoveAddress, k Keeper) (*sdk.Result, error) {
 	err := k.HandleRemoveAddress(ctx, msg.Owner, msg.BlockchainId, msg.Index)
 
@@ -251,4 +352,125 @@ func handleMsgRemoveAllAddresses(ctx sdk.Context, msg MsgRemoveAllAddresses, k K
 	))
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
-}
\ No newline at end of file
+}
+
+func handleMsgCreateAuction(ctx sdk.Context, msg MsgCreateAuction, k Keeper) (*sdk.Result, error) {
+	endTime, err := time.Parse("2006-01-02T15:04:05.99999999999Z", msg.EndTime)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidTime, msg.EndTime)
+	}
+
+	err = k.HandleCreateAuction(ctx, msg.Name, msg.Owner, msg.ReservePrice, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgPlaceBid(ctx sdk.Context, msg MsgPlaceBid, k Keeper) (*sdk.Result, error) {
+	err := k.HandlePlaceBid(ctx, msg.Name, msg.Bidder, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelAuction(ctx sdk.Context, msg MsgCancelAuction, k Keeper) (*sdk.Result, error) {
+	err := k.HandleCancelAuction(ctx, msg.Name, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCreateSubname(ctx sdk.Context, msg MsgCreateSubname, k Keeper) (*sdk.Result, error) {
+	err := k.HandleCreateSubname(ctx, msg.Name, msg.Owner, msg.SubnameOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevokeSubname(ctx sdk.Context, msg MsgRevokeSubname, k Keeper) (*sdk.Result, error) {
+	err := k.HandleRevokeSubname(ctx, msg.Name, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetPrimaryName(ctx sdk.Context, msg MsgSetPrimaryName, k Keeper) (*sdk.Result, error) {
+	err := k.HandleSetPrimaryName(ctx, msg.Name, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetTextRecord(ctx sdk.Context, msg MsgSetTextRecord, k Keeper) (*sdk.Result, error) {
+	err := k.HandleSetTextRecord(ctx, msg.Name, msg.Owner, msg.Key, msg.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRemoveTextRecord(ctx sdk.Context, msg MsgRemoveTextRecord, k Keeper) (*sdk.Result, error) {
+	err := k.HandleRemoveTextRecord(ctx, msg.Name, msg.Owner, msg.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+func handleMsgRegisterNameAddress(ctx sdk.Context, msg MsgRegisterNameAddress, k Keeper) (*sdk.Result, error) {
+	err := k.HandleRegisterNameAddress(ctx, msg.Name, msg.Owner, msg.BlockchainId, msg.Index, msg.BlockchainAddress, msg.Metadata())
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRemoveNameAddress(ctx sdk.Context, msg MsgRemoveNameAddress, k Keeper) (*sdk.Result, error) {
+	err := k.HandleRemoveNameAddress(ctx, msg.Name, msg.Owner, msg.BlockchainId, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgApprove(ctx sdk.Context, msg MsgApprove, k Keeper) (*sdk.Result, error) {
+	err := k.HandleApprove(ctx, msg.Name, msg.Owner, msg.Operator, msg.CanTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevokeApproval(ctx sdk.Context, msg MsgRevokeApproval, k Keeper) (*sdk.Result, error) {
+	err := k.HandleRevokeApproval(ctx, msg.Name, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetApprovalForAll(ctx sdk.Context, msg MsgSetApprovalForAll, k Keeper) (*sdk.Result, error) {
+	err := k.HandleSetApprovalForAll(ctx, msg.Owner, msg.Operator, msg.Approved, msg.CanTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -2,14 +2,15 @@ package keeper
 
 import (
 	sdk "github.com/DFWallet/anatha/types"
+	sdkerrors "github.com/DFWallet/anatha/types/errors"
 	"github.com/DFWallet/project-anatha/x/hra/internal/types"
 	"strings"
 )
 
-func (k Keeper) HandleRegisterAddress(ctx sdk.Context, address sdk.AccAddress, blockchainId string, index string, blockchainAddress string) error {
+func (k Keeper) HandleRegisterAddress(ctx sdk.Context, address sdk.AccAddress, blockchainId string, index string, blockchainAddress string, metadata types.AddressMetadata) error {
 	blockchainAddress = strings.TrimSpace(blockchainAddress)
 
-	if ! k.OwnsAnyName(ctx, address) {
+	if ! k.OwnsAnyName(ctx, address) && ! k.OwnsAnySubname(ctx, address) {
 		return types.ErrNoNamesRegistered
 	}
 
@@ -17,6 +18,58 @@ func (k Keeper) HandleRegisterAddress(ctx sdk.Context, address sdk.AccAddress, b
 		return types.ErrBlockchainIdNotValid
 	}
 
+	if err := k.ValidateBlockchainAddress(ctx, blockchainId, blockchainAddress); err != nil {
+		return err
+	}
+
+	err := k.ChargeRecordFee(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	k.SetAddress(ctx, address, blockchainId,index, blockchainAddress)
+	k.SetAddressMetadata(ctx, address, blockchainId, index, metadata)
+
+	return nil
+}
+
+// HandleSetAddresses checks all records before storing any of them, each record is charged as an address registration
+func (k Keeper) HandleSetAddresses(ctx sdk.Context, address sdk.AccAddress, addresses []types.BlockchainAddressInfo, replace bool) error {
+	if ! k.OwnsAnyName(ctx, address) && ! k.OwnsAnySubname(ctx, address) {
+		return types.ErrNoNamesRegistered
+	}
+
+	for i, info := range addresses {
+		addresses[i].BlockchainAddress = strings.TrimSpace(info.BlockchainAddress)
+
+		if ! k.IsBlockchainIdRegistered(ctx, info.BlockchainId) {
+			return types.ErrBlockchainIdNotValid
+		}
+
+		if err := k.ValidateBlockchainAddress(ctx, info.BlockchainId, addresses[i].BlockchainAddress); err != nil {
+			return sdkerrors.Wrapf(err, "%s%s%s", info.BlockchainId, types.Separator, info.Index)
+		}
+	}
+
+	if replace {
+		k.RemoveAllAddresses(ctx, address)
+	}
+
+	for _, info := range addresses {
+		err := k.ChargeRecordFee(ctx, address)
+		if err != nil {
+			return err
+		}
+
+		k.SetAddress(ctx, address, info.BlockchainId, info.Index, info.BlockchainAddress)
+		k.SetAddressMetadata(ctx, address, info.BlockchainId, info.Index, info.Metadata())
+	}
+
+	return nil
+}
+
+// ChargeRecordFee consumes one address credit or charges the address registration fee when no credits are left
+func (k Keeper) ChargeRecordFee(ctx sdk.Context, address sdk.AccAddress) error {
 	credits := k.GetCredits(ctx, address)
 
 	if credits.LTE(sdk.ZeroInt()) {
@@ -34,8 +87,6 @@ func (k Keeper) HandleRegisterAddress(ctx sdk.Context, address sdk.AccAddress, b
 		k.SetCredits(ctx, address, credits.Sub(sdk.OneInt()))
 	}
 
-	k.SetAddress(ctx, address, blockchainId,index, blockchainAddress)
-
 	return nil
 }
 
@@ -61,6 +112,7 @@ func (k Keeper) RemoveAddress(ctx sdk.Context, address sdk.AccAddress, blockchai
 	store := ctx.KVStore(k.storeKey)
 
 	store.Delete(types.GetAddressKey(address, blockchainId, index))
+	store.Delete(types.GetAddressMetadataKey(address, blockchainId, index))
 }
 
 func (k Keeper) RemoveAllAddresses(ctx sdk.Context, address sdk.AccAddress) {
@@ -73,6 +125,14 @@ func (k Keeper) RemoveAllAddresses(ctx sdk.Context, address sdk.AccAddress) {
 	for ; iterator.Valid(); iterator.Next() {
 		store.Delete(iterator.Key())
 	}
+
+	metadataIterator := sdk.KVStorePrefixIterator(store, types.GetAddressMetadataIteratorKey(address))
+
+	defer metadataIterator.Close()
+
+	for ; metadataIterator.Valid(); metadataIterator.Next() {
+		store.Delete(metadataIterator.Key())
+	}
 }
 
 func (k Keeper) GetAddress(ctx sdk.Context, address sdk.AccAddress, blockchainId string, index string) (string, error) {
@@ -87,6 +147,61 @@ func (k Keeper) GetAddress(ctx sdk.Context, address sdk.AccAddress, blockchainId
 	return string(blockchainAddress), nil
 }
 
+func (k Keeper) GetAddressMetadata(ctx sdk.Context, address sdk.AccAddress, blockchainId string, index string) types.AddressMetadata {
+	store := ctx.KVStore(k.storeKey)
+
+	var metadata types.AddressMetadata
+
+	bz := store.Get(types.GetAddressMetadataKey(address, blockchainId, index))
+	if bz == nil {
+		return metadata
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+
+	return metadata
+}
+
+// SetAddressMetadata stores the metadata of an address record, a default record replaces the previous default of the blockchain id
+func (k Keeper) SetAddressMetadata(ctx sdk.Context, address sdk.AccAddress, blockchainId string, index string, metadata types.AddressMetadata) {
+	store := ctx.KVStore(k.storeKey)
+
+	if metadata.Default {
+		currentIndex, found := k.GetDefaultAddressIndex(ctx, address, blockchainId)
+		if found && currentIndex != index {
+			current := k.GetAddressMetadata(ctx, address, blockchainId, currentIndex)
+			current.Default = false
+
+			k.SetAddressMetadata(ctx, address, blockchainId, currentIndex, current)
+		}
+	}
+
+	if metadata.IsEmpty() {
+		store.Delete(types.GetAddressMetadataKey(address, blockchainId, index))
+		return
+	}
+
+	store.Set(types.GetAddressMetadataKey(address, blockchainId, index), k.cdc.MustMarshalBinaryBare(metadata))
+}
+
+// GetDefaultAddressIndex returns the index flagged as default for the blockchain id
+func (k Keeper) GetDefaultAddressIndex(ctx sdk.Context, address sdk.AccAddress, blockchainId string) (string, bool) {
+	index := ""
+	found := false
+
+	k.IterateBlockchainAddressInfos(ctx, address, func(info types.BlockchainAddressInfo) (stop bool) {
+		if info.BlockchainId == blockchainId && info.Default {
+			index = info.Index
+			found = true
+			return true
+		}
+
+		return false
+	})
+
+	return index, found
+}
+
 // Returns an iterator through users blockchain addresses
 func (k Keeper) GetAddressIterator(ctx sdk.Context, address sdk.AccAddress) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -101,6 +216,7 @@ func (k Keeper) IterateBlockchainAddressInfos(ctx sdk.Context, address sdk.AccAd
 	for ; iterator.Valid(); iterator.Next() {
 		blockchainAddress := types.SplitBlockchainAddressKey(iterator.Key())
 		blockchainAddress.BlockchainAddress = string(iterator.Value())
+		blockchainAddress.SetMetadata(k.GetAddressMetadata(ctx, address, blockchainAddress.BlockchainId, blockchainAddress.Index))
 
 		if cb(blockchainAddress) {
 			break
@@ -122,6 +238,12 @@ func (k Keeper) IterateAllBlockchainAddressInfos(ctx sdk.Context, cb func(blockc
 	for ; iterator.Valid(); iterator.Next() {
 		blockchainAddressRecord := types.SplitBlockchainAddressRecordKey(iterator.Key())
 		blockchainAddressRecord.BlockchainAddressInfo.BlockchainAddress = string(iterator.Value())
+		blockchainAddressRecord.BlockchainAddressInfo.SetMetadata(k.GetAddressMetadata(
+			ctx,
+			blockchainAddressRecord.Address,
+			blockchainAddressRecord.BlockchainAddressInfo.BlockchainId,
+			blockchainAddressRecord.BlockchainAddressInfo.Index,
+		))
 
 		if cb(blockchainAddressRecord) {
 			break
@@ -240,4 +362,73 @@ func (k Keeper) GetRegisteredBlockchainIds(ctx sdk.Context) []string {
 	})
 
 	return registeredBlockchainIds
+}
+
+// ValidateBlockchainAddress checks the address format with the validator assigned to the blockchain id, falling back to the generic length check
+func (k Keeper) ValidateBlockchainAddress(ctx sdk.Context, blockchainId string, blockchainAddress string) error {
+	name, found := k.GetBlockchainIdValidator(ctx, blockchainId)
+	if ! found {
+		name = types.AddressValidatorGeneric
+	}
+
+	validator, ok := types.GetAddressValidator(name)
+	if ! ok {
+		return sdkerrors.Wrapf(types.ErrAddressValidatorNotValid, "unknown address validator %s", name)
+	}
+
+	return validator(blockchainAddress)
+}
+
+func (k Keeper) GetBlockchainIdValidator(ctx sdk.Context, blockchainId string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	blockchainId = strings.ToLower(blockchainId)
+
+	bz := store.Get(types.GetBlockchainIdValidatorKey(blockchainId))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+func (k Keeper) SetBlockchainIdValidator(ctx sdk.Context, blockchainId string, addressValidator string) {
+	store := ctx.KVStore(k.storeKey)
+
+	blockchainId = strings.ToLower(blockchainId)
+
+	store.Set(types.GetBlockchainIdValidatorKey(blockchainId), []byte(addressValidator))
+}
+
+func (k Keeper) RemoveBlockchainIdValidator(ctx sdk.Context, blockchainId string) {
+	store := ctx.KVStore(k.storeKey)
+
+	blockchainId = strings.ToLower(blockchainId)
+
+	store.Delete(types.GetBlockchainIdValidatorKey(blockchainId))
+}
+
+func (k Keeper) IterateBlockchainIdValidators(ctx sdk.Context, cb func(blockchainIdValidator types.BlockchainIdValidator) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BlockchainIdValidatorKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		blockchainId := string(iterator.Key()[1:])
+
+		if cb(types.NewBlockchainIdValidator(blockchainId, string(iterator.Value()))) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetBlockchainIdValidators(ctx sdk.Context) []types.BlockchainIdValidator {
+	blockchainIdValidators := make([]types.BlockchainIdValidator, 0)
+
+	k.IterateBlockchainIdValidators(ctx, func(blockchainIdValidator types.BlockchainIdValidator) (stop bool) {
+		blockchainIdValidators = append(blockchainIdValidators, blockchainIdValidator)
+		return false
+	})
+
+	return blockchainIdValidators
 }
\ No newline at end of file
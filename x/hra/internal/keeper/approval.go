@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/x/hra/internal/types"
+	"strconv"
+)
+
+// HandleApprove sets the operator of a single name, replacing the previous one
+func (k Keeper) HandleApprove(ctx sdk.Context, name string, owner sdk.AccAddress, operator sdk.AccAddress, canTransfer bool) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! owner.Equals(nameInfo.Owner) {
+		return types.ErrNotOwner
+	}
+
+	if owner.Equals(operator) {
+		return types.ErrInvalidOperator
+	}
+
+	if nameInfo.IsExpired(ctx.BlockTime()) {
+		return types.ErrNameInGracePeriod
+	}
+
+	k.SetNameApproval(ctx, types.NewApproval(name, owner, operator, canTransfer))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApprove,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+			sdk.NewAttribute(types.AttributeKeyCanTransfer, strconv.FormatBool(canTransfer)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) HandleRevokeApproval(ctx sdk.Context, name string, owner sdk.AccAddress) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! owner.Equals(nameInfo.Owner) {
+		return types.ErrNotOwner
+	}
+
+	approval, found := k.GetNameApproval(ctx, name)
+	if ! found {
+		return types.ErrApprovalNotFound
+	}
+
+	k.DeleteNameApproval(ctx, name)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeApproval,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyOperator, approval.Operator.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+// HandleSetApprovalForAll approves or revokes an operator for every name of the owner, including names acquired later
+func (k Keeper) HandleSetApprovalForAll(ctx sdk.Context, owner sdk.AccAddress, operator sdk.AccAddress, approved bool, canTransfer bool) error {
+	if owner.Equals(operator) {
+		return types.ErrInvalidOperator
+	}
+
+	if approved {
+		k.SetOperatorApproval(ctx, types.NewApproval("", owner, operator, canTransfer))
+	} else {
+		k.DeleteOperatorApproval(ctx, owner, operator)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetApprovalForAll,
+			sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+			sdk.NewAttribute(types.AttributeKeyApproved, strconv.FormatBool(approved)),
+			sdk.NewAttribute(types.AttributeKeyCanTransfer, strconv.FormatBool(canTransfer)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+// CanManageName tells whether the address may update the records of the name and renew it
+func (k Keeper) CanManageName(ctx sdk.Context, nameInfo types.NameInfo, address sdk.AccAddress) bool {
+	if address.Equals(nameInfo.Owner) {
+		return true
+	}
+
+	_, found := k.getOperatorApproval(ctx, nameInfo, address)
+
+	return found
+}
+
+// CanTransferName tells whether the address may transfer, sell or delete the name
+func (k Keeper) CanTransferName(ctx sdk.Context, nameInfo types.NameInfo, address sdk.AccAddress) bool {
+	if address.Equals(nameInfo.Owner) {
+		return true
+	}
+
+	approval, found := k.getOperatorApproval(ctx, nameInfo, address)
+
+	return found && approval.CanTransfer
+}
+
+func (k Keeper) SetNameApproval(ctx sdk.Context, approval types.Approval) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetNameApprovalKey(approval.Name), k.cdc.MustMarshalBinaryBare(approval))
+}
+
+func (k Keeper) GetNameApproval(ctx sdk.Context, name string) (types.Approval, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var approval types.Approval
+
+	bz := store.Get(types.GetNameApprovalKey(name))
+	if bz == nil {
+		return approval, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &approval)
+
+	return approval, true
+}
+
+// DeleteNameApproval clears the operator of a name, used when the name changes owner or is removed
+func (k Keeper) DeleteNameApproval(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetNameApprovalKey(name))
+}
+
+func (k Keeper) SetOperatorApproval(ctx sdk.Context, approval types.Approval) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetOperatorApprovalKey(approval.Owner, approval.Operator), k.cdc.MustMarshalBinaryBare(approval))
+}
+
+func (k Keeper) GetOperatorApproval(ctx sdk.Context, owner sdk.AccAddress, operator sdk.AccAddress) (types.Approval, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var approval types.Approval
+
+	bz := store.Get(types.GetOperatorApprovalKey(owner, operator))
+	if bz == nil {
+		return approval, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &approval)
+
+	return approval, true
+}
+
+func (k Keeper) DeleteOperatorApproval(ctx sdk.Context, owner sdk.AccAddress, operator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetOperatorApprovalKey(owner, operator))
+}
+
+func (k Keeper) IterateNameApprovals(ctx sdk.Context, cb func(approval types.Approval) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.NameApprovalKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.Approval
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &approval)
+
+		if cb(approval) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateOperatorApprovals(ctx sdk.Context, cb func(approval types.Approval) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OperatorApprovalKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.Approval
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &approval)
+
+		if cb(approval) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateOperatorApprovalsByOwner(ctx sdk.Context, owner sdk.AccAddress, cb func(approval types.Approval) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetOperatorApprovalIteratorKey(owner))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.Approval
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &approval)
+
+		if cb(approval) {
+			break
+		}
+	}
+}
+
+// getOperatorApproval returns the approval of the address for the name, the per name approval takes precedence
+func (k Keeper) getOperatorApproval(ctx sdk.Context, nameInfo types.NameInfo, address sdk.AccAddress) (types.Approval, bool) {
+	approval, found := k.GetNameApproval(ctx, nameInfo.Name)
+	if found && approval.Owner.Equals(nameInfo.Owner) && approval.Operator.Equals(address) {
+		return approval, true
+	}
+
+	return k.GetOperatorApproval(ctx, nameInfo.Owner, address)
+}
+
+// IsNameOwner tells whether the address is the owner of the name, as opposed to an operator
+func (k Keeper) IsNameOwner(ctx sdk.Context, name string, address sdk.AccAddress) bool {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+
+	return found && address.Equals(nameInfo.Owner)
+}
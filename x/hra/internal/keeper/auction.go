@@ -5,6 +5,7 @@ import (
 	sdkerrors "github.com/DFWallet/anatha/types/errors"
 	"github.com/DFWallet/project-anatha/config"
 	"github.com/DFWallet/project-anatha/x/hra/internal/types"
+	"time"
 )
 
 func (k Keeper) HandleSetPrice(ctx sdk.Context, name string, owner sdk.AccAddress, price sdk.Coins) error {
@@ -13,10 +14,19 @@ func (k Keeper) HandleSetPrice(ctx sdk.Context, name string, owner sdk.AccAddres
 		return types.ErrNameNotRegistered
 	}
 
-	if ! owner.Equals(nameInfo.Owner) {
+	// listing a name for sale hands it over to the buyer, so it needs the transfer permission
+	if ! k.CanTransferName(ctx, nameInfo, owner) {
 		return types.ErrNotOwner
 	}
 
+	if nameInfo.IsExpired(ctx.BlockTime()) {
+		return types.ErrNameInGracePeriod
+	}
+
+	if k.HasAuction(ctx, name) {
+		return types.ErrAuctionInProgress
+	}
+
 	nameInfo.Price = price
 
 	k.SetNameInfo(ctx, name, nameInfo)
@@ -43,6 +53,14 @@ func (k Keeper) HandleBuyName(ctx sdk.Context, name string, buyer sdk.AccAddress
 		return types.ErrAlreadyOwned
 	}
 
+	if nameInfo.IsExpired(ctx.BlockTime()) {
+		return types.ErrNameInGracePeriod
+	}
+
+	if k.HasAuction(ctx, name) {
+		return types.ErrAuctionInProgress
+	}
+
 	if nameInfo.Price.IsZero() {
 		return types.ErrNotForSale
 	}
@@ -66,6 +84,10 @@ func (k Keeper) HandleBuyName(ctx sdk.Context, name string, buyer sdk.AccAddress
 	// update the status mapping
 	k.DeleteNameInfoStatusMap(ctx, nameInfo.Owner, name)
 	k.SetNameInfoStatusMap(ctx,buyer, name)
+	k.RemoveSubnames(ctx, name)
+	k.RemoveTextRecords(ctx, name)
+	k.RemoveNameAddresses(ctx, name)
+	k.DeleteNameApproval(ctx, name)
 
 	oldOwner := nameInfo.Owner
 
@@ -76,7 +98,213 @@ func (k Keeper) HandleBuyName(ctx sdk.Context, name string, buyer sdk.AccAddress
 	k.SetNameInfo(ctx, name, nameInfo)
 
 	if ! k.OwnsAnyName(ctx, oldOwner) {
-		k.RemoveAllAddresses(ctx, oldOwner)
+		if ! k.OwnsAnySubname(ctx, oldOwner) {
+			k.RemoveAllAddresses(ctx, oldOwner)
+		}
+
+		k.SetCredits(ctx, oldOwner, sdk.ZeroInt())
+		err = k.AfterLastNameRemoved(ctx, oldOwner)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) HandleCreateAuction(ctx sdk.Context, name string, owner sdk.AccAddress, reservePrice sdk.Coins, endTime time.Time) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! owner.Equals(nameInfo.Owner) {
+		return types.ErrNotOwner
+	}
+
+	if nameInfo.IsExpired(ctx.BlockTime()) {
+		return types.ErrNameInGracePeriod
+	}
+
+	if k.HasAuction(ctx, name) {
+		return types.ErrAuctionInProgress
+	}
+
+	// the auction has to be settled before the name expires
+	if ! endTime.After(ctx.BlockTime()) || endTime.After(nameInfo.ExpiryTime) {
+		return sdkerrors.Wrap(types.ErrInvalidTime, endTime.String())
+	}
+
+	// a fixed price listing is replaced by the auction
+	nameInfo.Price = sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, 0))
+	k.SetNameInfo(ctx, name, nameInfo)
+
+	auction := types.NewAuction(name, owner, reservePrice, endTime)
+
+	k.SetAuction(ctx, auction)
+	k.InsertAuctionQueue(ctx, name, endTime)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateAuction,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyReservePrice, reservePrice.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, endTime.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) HandlePlaceBid(ctx sdk.Context, name string, bidder sdk.AccAddress, amount sdk.Coins) error {
+	auction, found := k.GetAuction(ctx, name)
+	if ! found {
+		return types.ErrAuctionNotFound
+	}
+
+	if ! ctx.BlockTime().Before(auction.EndTime) {
+		return types.ErrAuctionEnded
+	}
+
+	if auction.Owner.Equals(bidder) {
+		return types.ErrAlreadyOwned
+	}
+
+	bidAmount := amount.AmountOf(config.DefaultDenom)
+
+	if bidAmount.LT(auction.ReservePrice.AmountOf(config.DefaultDenom)) {
+		return sdkerrors.Wrapf(types.ErrBidTooLow, "bid must be at least the reserve price of %s", auction.ReservePrice)
+	}
+
+	if auction.HasBids() && bidAmount.LTE(auction.HighestBid.AmountOf(config.DefaultDenom)) {
+		return sdkerrors.Wrapf(types.ErrBidTooLow, "bid must be higher than %s", auction.HighestBid)
+	}
+
+	err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.AuctionEscrowModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	// refund the outbid bidder
+	if auction.HasBids() {
+		err = k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.AuctionEscrowModuleName, auction.HighestBidder, auction.HighestBid)
+		if err != nil {
+			return err
+		}
+	}
+
+	auction.HighestBidder = bidder
+	auction.HighestBid = amount
+
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePlaceBid,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, bidder.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) HandleCancelAuction(ctx sdk.Context, name string, owner sdk.AccAddress) error {
+	auction, found := k.GetAuction(ctx, name)
+	if ! found {
+		return types.ErrAuctionNotFound
+	}
+
+	if ! owner.Equals(auction.Owner) {
+		return types.ErrNotOwner
+	}
+
+	if auction.HasBids() {
+		return types.ErrAuctionHasBids
+	}
+
+	k.RemoveFromAuctionQueue(ctx, name, auction.EndTime)
+	k.DeleteAuction(ctx, name)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelAuction,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+// SettleAuction closes an ended auction. The escrowed highest bid is paid to the owner
+// and the name is transferred to the highest bidder. Auctions without bids are closed.
+func (k Keeper) SettleAuction(ctx sdk.Context, auction types.Auction) error {
+	k.RemoveFromAuctionQueue(ctx, auction.Name, auction.EndTime)
+	k.DeleteAuction(ctx, auction.Name)
+
+	if ! auction.HasBids() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSettleAuction,
+				sdk.NewAttribute(types.AttributeKeyName, auction.Name),
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			),
+		)
+
+		return nil
+	}
+
+	nameInfo, found := k.GetNameInfo(ctx, auction.Name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.AuctionEscrowModuleName, nameInfo.Owner, auction.HighestBid)
+	if err != nil {
+		return err
+	}
+
+	winner := auction.HighestBidder
+	oldOwner := nameInfo.Owner
+
+	if ! k.OwnsAnyName(ctx, winner) {
+		k.SetCredits(ctx, winner, k.AddressCredits(ctx))
+		k.AfterFirstNameCreated(ctx, winner)
+	}
+
+	// update the status mapping
+	k.DeleteNameInfoStatusMap(ctx, oldOwner, auction.Name)
+	k.SetNameInfoStatusMap(ctx, winner, auction.Name)
+	k.RemoveSubnames(ctx, auction.Name)
+	k.RemoveTextRecords(ctx, auction.Name)
+	k.RemoveNameAddresses(ctx, auction.Name)
+	k.DeleteNameApproval(ctx, auction.Name)
+
+	// update the owner and reset the price
+	nameInfo.Owner = winner
+	nameInfo.Price = sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, 0))
+
+	k.SetNameInfo(ctx, auction.Name, nameInfo)
+
+	if ! k.OwnsAnyName(ctx, oldOwner) {
+		if ! k.OwnsAnySubname(ctx, oldOwner) {
+			k.RemoveAllAddresses(ctx, oldOwner)
+		}
 
 		k.SetCredits(ctx, oldOwner, sdk.ZeroInt())
 		err = k.AfterLastNameRemoved(ctx, oldOwner)
@@ -85,5 +313,104 @@ func (k Keeper) HandleBuyName(ctx sdk.Context, name string, buyer sdk.AccAddress
 		}
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettleAuction,
+			sdk.NewAttribute(types.AttributeKeyName, auction.Name),
+			sdk.NewAttribute(types.AttributeKeyWinner, winner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, auction.HighestBid.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
 	return nil
-}
\ No newline at end of file
+}
+
+func (k Keeper) GetAuction(ctx sdk.Context, name string) (types.Auction, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetAuctionKey(name))
+	if bz == nil {
+		return types.Auction{}, false
+	}
+
+	var auction types.Auction
+	k.cdc.MustUnmarshalBinaryBare(bz, &auction)
+
+	return auction, true
+}
+
+func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetAuctionKey(auction.Name), k.cdc.MustMarshalBinaryBare(auction))
+}
+
+func (k Keeper) DeleteAuction(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetAuctionKey(name))
+}
+
+func (k Keeper) HasAuction(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetAuctionKey(name))
+}
+
+func (k Keeper) GetAuctionsIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.AuctionKeyPrefix)
+}
+
+func (k Keeper) IterateAuctions(ctx sdk.Context, cb func(auction types.Auction) (stop bool)) {
+	iterator := k.GetAuctionsIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.Auction
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &auction)
+
+		if cb(auction) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetAuctions(ctx sdk.Context) []types.Auction {
+	var auctions []types.Auction
+	k.IterateAuctions(ctx, func(auction types.Auction) (stop bool) {
+		auctions = append(auctions, auction)
+		return false
+	})
+
+	return auctions
+}
+
+func (k Keeper) IterateEndedAuctionQueue(ctx sdk.Context, endTime time.Time, cb func(name string) (stop bool)) {
+	iterator := k.EndedAuctionQueueIterator(ctx, endTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		name, _ := types.SplitAuctionQueueKey(iterator.Key())
+
+		if cb(name) {
+			break
+		}
+	}
+}
+
+func (k Keeper) InsertAuctionQueue(ctx sdk.Context, name string, endTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AuctionQueueKey(name, endTime), []byte(name))
+}
+
+func (k Keeper) RemoveFromAuctionQueue(ctx sdk.Context, name string, endTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AuctionQueueKey(name, endTime))
+}
+
+func (k Keeper) EndedAuctionQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.AuctionQueueKeyPrefix, sdk.PrefixEndBytes(types.AuctionByTimeKey(endTime)))
+}
@@ -33,10 +33,16 @@ func (keeper Keeper) RemoveFromExpiredNameInfoQueue(ctx sdk.Context, name string
 func (k Keeper) DeleteExpiredNameInfo(ctx sdk.Context, nameInfo types.NameInfo) error {
 	k.DeleteNameInfo(ctx, nameInfo.Name)
 	k.DeleteNameInfoStatusMap(ctx, nameInfo.Owner, nameInfo.Name)
+	k.RemoveSubnames(ctx, nameInfo.Name)
+	k.RemoveTextRecords(ctx, nameInfo.Name)
+	k.RemoveNameAddresses(ctx, nameInfo.Name)
+	k.DeleteNameApproval(ctx, nameInfo.Name)
 
 	// if last HRA remove all associated addresses
 	if ! k.OwnsAnyName(ctx, nameInfo.Owner) {
-		k.RemoveAllAddresses(ctx, nameInfo.Owner)
+		if ! k.OwnsAnySubname(ctx, nameInfo.Owner) {
+			k.RemoveAllAddresses(ctx, nameInfo.Owner)
+		}
 		k.SetCredits(ctx, nameInfo.Owner, sdk.ZeroInt())
 		err := k.AfterLastNameRemoved(ctx, nameInfo.Owner)
 		if err != nil {
@@ -45,6 +51,7 @@ func (k Keeper) DeleteExpiredNameInfo(ctx sdk.Context, nameInfo types.NameInfo)
 	}
 
 	k.RemoveFromExpiredNameInfoQueue(ctx, nameInfo.Name, nameInfo.ExpiryTime)
+	k.RemoveFromGracePeriodQueue(ctx, nameInfo.Name, nameInfo.ExpiryTime)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -62,3 +69,78 @@ func (keeper Keeper) ExpiredNameInfoQueueIterator(ctx sdk.Context, endTime time.
 	store := ctx.KVStore(keeper.storeKey)
 	return store.Iterator(types.ExpiredNameInfoQueueKeyPrefix, sdk.PrefixEndBytes(types.ExpiredNameInfoByTimeKey(endTime)))
 }
+
+// StartGracePeriod freezes an expired name until the grace period ends. During the grace period the name
+// does not resolve and can only be redeemed by its owner.
+func (k Keeper) StartGracePeriod(ctx sdk.Context, nameInfo types.NameInfo) {
+	k.RemoveFromExpiredNameInfoQueue(ctx, nameInfo.Name, nameInfo.ExpiryTime)
+	k.InsertGracePeriodQueue(ctx, nameInfo.Name, nameInfo.ExpiryTime)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameGracePeriod,
+			sdk.NewAttribute(types.AttributeKeyName, nameInfo.Name),
+			sdk.NewAttribute(types.AttributeKeySender, nameInfo.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyGracePeriodEnd, k.GracePeriodEnd(ctx, nameInfo).String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+}
+
+func (k Keeper) GracePeriodEnd(ctx sdk.Context, nameInfo types.NameInfo) time.Time {
+	return nameInfo.ExpiryTime.Add(k.NameInfoGracePeriod(ctx))
+}
+
+// IsNameInGracePeriod returns true if the name is registered and expired, but not yet deleted
+func (k Keeper) IsNameInGracePeriod(ctx sdk.Context, name string) bool {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return false
+	}
+
+	return nameInfo.IsExpired(ctx.BlockTime())
+}
+
+// IterateGracePeriodQueue iterates over names whose grace period ended before endTime
+func (k Keeper) IterateGracePeriodQueue(ctx sdk.Context, endTime time.Time, cb func(name string) (stop bool)) {
+	// names are queued by their expiry time
+	iterator := k.GracePeriodQueueIterator(ctx, endTime.Add(-k.NameInfoGracePeriod(ctx)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		name, _ := types.SplitGracePeriodQueueKey(iterator.Key())
+
+		if cb(name) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAllGracePeriodQueue(ctx sdk.Context, cb func(name string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GracePeriodQueueKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		name, _ := types.SplitGracePeriodQueueKey(iterator.Key())
+
+		if cb(name) {
+			break
+		}
+	}
+}
+
+func (k Keeper) InsertGracePeriodQueue(ctx sdk.Context, name string, expiryTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GracePeriodQueueKey(name, expiryTime), []byte(name))
+}
+
+func (k Keeper) RemoveFromGracePeriodQueue(ctx sdk.Context, name string, expiryTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GracePeriodQueueKey(name, expiryTime))
+}
+
+func (k Keeper) GracePeriodQueueIterator(ctx sdk.Context, expiryTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.GracePeriodQueueKeyPrefix, sdk.PrefixEndBytes(types.GracePeriodByTimeKey(expiryTime)))
+}
@@ -36,6 +36,11 @@ func (k Keeper) DeleteNameInfoStatusMap(ctx sdk.Context, owner sdk.AccAddress, n
 	store := ctx.KVStore(k.storeKey)
 
 	store.Delete(types.GetStatusByAddressAndNameKey(owner, name))
+
+	// the name can no longer be the primary name of its previous owner
+	if primaryName, found := k.GetPrimaryName(ctx, owner); found && primaryName == name {
+		k.DeletePrimaryName(ctx, owner)
+	}
 }
 
 func (k Keeper) SetNameInfoStatusMap(ctx sdk.Context, owner sdk.AccAddress, name string) {
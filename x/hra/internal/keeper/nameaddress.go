@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/x/hra/internal/types"
+	"strconv"
+	"strings"
+)
+
+// HandleRegisterNameAddress stores an address record on the name itself, once a name has its own records they override the owner's records
+func (k Keeper) HandleRegisterNameAddress(ctx sdk.Context, name string, owner sdk.AccAddress, blockchainId string, index string, blockchainAddress string, metadata types.AddressMetadata) error {
+	blockchainAddress = strings.TrimSpace(blockchainAddress)
+
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! k.CanManageName(ctx, nameInfo, owner) {
+		return types.ErrNotOwner
+	}
+
+	if nameInfo.IsExpired(ctx.BlockTime()) {
+		return types.ErrNameInGracePeriod
+	}
+
+	if ! k.IsBlockchainIdRegistered(ctx, blockchainId) {
+		return types.ErrBlockchainIdNotValid
+	}
+
+	if err := k.ValidateBlockchainAddress(ctx, blockchainId, blockchainAddress); err != nil {
+		return err
+	}
+
+	err := k.ChargeRecordFee(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	info := types.NewBlockchainAddressInfo(blockchainId, index, blockchainAddress)
+	info.SetMetadata(metadata)
+
+	k.SetNameAddress(ctx, name, info)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterNameAddress,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyBlockchainId, blockchainId),
+			sdk.NewAttribute(types.AttributeKeyIndex, index),
+			sdk.NewAttribute(types.AttributeKeyBlockchainAddress, blockchainAddress),
+			sdk.NewAttribute(types.AttributeKeyLabel, metadata.Label),
+			sdk.NewAttribute(types.AttributeKeyMemo, metadata.Memo),
+			sdk.NewAttribute(types.AttributeKeyDefault, strconv.FormatBool(metadata.Default)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+// HandleRemoveNameAddress removes a name level record, removing the last one makes the name resolve to the owner's records again
+func (k Keeper) HandleRemoveNameAddress(ctx sdk.Context, name string, owner sdk.AccAddress, blockchainId string, index string) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! k.CanManageName(ctx, nameInfo, owner) {
+		return types.ErrNotOwner
+	}
+
+	if _, found := k.GetNameAddress(ctx, name, blockchainId, index); ! found {
+		return types.ErrBlockchainAddressNotFound
+	}
+
+	k.DeleteNameAddress(ctx, name, blockchainId, index)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveNameAddress,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyBlockchainId, blockchainId),
+			sdk.NewAttribute(types.AttributeKeyIndex, index),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+// SetNameAddress stores a name level record, a default record replaces the previous default of the blockchain id
+func (k Keeper) SetNameAddress(ctx sdk.Context, name string, info types.BlockchainAddressInfo) {
+	store := ctx.KVStore(k.storeKey)
+
+	if info.Default {
+		currentIndex, found := k.GetDefaultNameAddressIndex(ctx, name, info.BlockchainId)
+		if found && currentIndex != info.Index {
+			current, _ := k.GetNameAddress(ctx, name, info.BlockchainId, currentIndex)
+			current.Default = false
+
+			store.Set(types.GetNameAddressKey(name, current.BlockchainId, current.Index), k.cdc.MustMarshalBinaryBare(current))
+		}
+	}
+
+	store.Set(types.GetNameAddressKey(name, info.BlockchainId, info.Index), k.cdc.MustMarshalBinaryBare(info))
+}
+
+func (k Keeper) GetNameAddress(ctx sdk.Context, name string, blockchainId string, index string) (types.BlockchainAddressInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var info types.BlockchainAddressInfo
+
+	bz := store.Get(types.GetNameAddressKey(name, blockchainId, index))
+	if bz == nil {
+		return info, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+
+	return info, true
+}
+
+func (k Keeper) DeleteNameAddress(ctx sdk.Context, name string, blockchainId string, index string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetNameAddressKey(name, blockchainId, index))
+}
+
+// HasNameAddresses tells whether the name resolves to its own address set instead of the owner's
+func (k Keeper) HasNameAddresses(ctx sdk.Context, name string) bool {
+	iterator := k.GetNameAddressIterator(ctx, name)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// RemoveNameAddresses clears the name level records, used when the name changes owner or is removed
+func (k Keeper) RemoveNameAddresses(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := k.GetNameAddressIterator(ctx, name)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
+
+func (k Keeper) GetDefaultNameAddressIndex(ctx sdk.Context, name string, blockchainId string) (string, bool) {
+	index := ""
+	found := false
+
+	k.IterateNameAddresses(ctx, name, func(info types.BlockchainAddressInfo) (stop bool) {
+		if info.BlockchainId == blockchainId && info.Default {
+			index = info.Index
+			found = true
+			return true
+		}
+
+		return false
+	})
+
+	return index, found
+}
+
+func (k Keeper) GetNameAddressIterator(ctx sdk.Context, name string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetNameAddressIteratorKey(name))
+}
+
+func (k Keeper) IterateNameAddresses(ctx sdk.Context, name string, cb func(info types.BlockchainAddressInfo) (stop bool)) {
+	iterator := k.GetNameAddressIterator(ctx, name)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.BlockchainAddressInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+
+		if cb(info) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAllNameAddresses(ctx sdk.Context, cb func(record types.NameBlockchainAddressInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.NameAddressKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.BlockchainAddressInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+
+		if cb(types.NewNameBlockchainAddressInfo(types.SplitNameAddressKey(iterator.Key()), info)) {
+			break
+		}
+	}
+}
+
+// IterateResolvedAddresses walks the addresses a name resolves to, the name level set when present and the owner's set otherwise
+func (k Keeper) IterateResolvedAddresses(ctx sdk.Context, name string, owner sdk.AccAddress, cb func(info types.BlockchainAddressInfo) (stop bool)) {
+	if k.HasNameAddresses(ctx, name) {
+		k.IterateNameAddresses(ctx, name, cb)
+		return
+	}
+
+	k.IterateBlockchainAddressInfos(ctx, owner, cb)
+}
+
+// ResolveAddress returns the address a name resolves to for the blockchain id, an empty index resolves the default record
+func (k Keeper) ResolveAddress(ctx sdk.Context, name string, owner sdk.AccAddress, blockchainId string, index string) (string, error) {
+	if k.HasNameAddresses(ctx, name) {
+		if index == "" {
+			index = "0"
+			if defaultIndex, found := k.GetDefaultNameAddressIndex(ctx, name, blockchainId); found {
+				index = defaultIndex
+			}
+		}
+
+		info, found := k.GetNameAddress(ctx, name, blockchainId, index)
+		if ! found {
+			return "", types.ErrBlockchainAddressNotFound
+		}
+
+		return info.BlockchainAddress, nil
+	}
+
+	if index == "" {
+		index = "0"
+		if defaultIndex, found := k.GetDefaultAddressIndex(ctx, owner, blockchainId); found {
+			index = defaultIndex
+		}
+	}
+
+	return k.GetAddress(ctx, owner, blockchainId, index)
+}
@@ -13,6 +13,15 @@ func (k Keeper) HandleRegisterName(ctx sdk.Context, name string, owner sdk.AccAd
 		return types.ErrNameRegistered
 	}
 
+	if k.HasSubname(ctx, name) {
+		return types.ErrNameRegistered
+	}
+
+	// names under a registered parent are reserved for its subnames
+	if _, parent, ok := types.SplitSubname(name); ok && k.IsNameRegistered(ctx, parent) {
+		return types.ErrSubnameReserved
+	}
+
 	err := k.SupplyKeeper.SendCoinsFromAccountToModule(
 		ctx,
 		owner,
@@ -65,15 +74,28 @@ func (k Keeper) HandleRenewName(ctx sdk.Context, name string, owner sdk.AccAddre
 		return types.ErrNameNotRegistered
 	}
 
-	if ! owner.Equals(nameInfo.Owner) {
+	// approved operators renew on behalf of the owner and pay the fee themselves
+	if ! k.CanManageName(ctx, nameInfo, owner) {
 		return types.ErrNotOwner
 	}
 
+	inGracePeriod := nameInfo.IsExpired(ctx.BlockTime())
+
+	if inGracePeriod && ! ctx.BlockTime().Before(k.GracePeriodEnd(ctx, nameInfo)) {
+		return types.ErrExpiredNameRenewal
+	}
+
+	fee := k.NameInfoRenewalFee(ctx)
+	if inGracePeriod {
+		// redeeming a name in the grace period is charged with a penalty
+		fee = fee.Add(k.NameInfoRedemptionFee(ctx)...)
+	}
+
 	err := k.SupplyKeeper.SendCoinsFromAccountToModule(
 		ctx,
 		owner,
 		k.feeCollectorName,
-		k.NameInfoRenewalFee(ctx),
+		fee,
 	)
 	if err != nil {
 		return err
@@ -81,11 +103,11 @@ func (k Keeper) HandleRenewName(ctx sdk.Context, name string, owner sdk.AccAddre
 
 	oldExpiryTime := nameInfo.ExpiryTime
 
-	if ctx.BlockTime().Before(nameInfo.ExpiryTime) {
-		// If renewal is done before the expiry time, renew for 1 year after the expiry time
-		nameInfo.ExpiryTime = nameInfo.ExpiryTime.Add(k.NameInfoDuration(ctx))
-	} else {
-		return types.ErrExpiredNameRenewal
+	// Renew for 1 year after the expiry time
+	nameInfo.ExpiryTime = nameInfo.ExpiryTime.Add(k.NameInfoDuration(ctx))
+
+	if ! nameInfo.ExpiryTime.After(ctx.BlockTime()) {
+		nameInfo.ExpiryTime = ctx.BlockTime().Add(k.NameInfoDuration(ctx))
 	}
 
 	if nameInfo.ExpiryTime.After(ctx.BlockTime().Add(k.NameInfoMaxDuration(ctx))) {
@@ -93,8 +115,19 @@ func (k Keeper) HandleRenewName(ctx sdk.Context, name string, owner sdk.AccAddre
 	}
 
 	k.RemoveFromExpiredNameInfoQueue(ctx, name, oldExpiryTime)
+	k.RemoveFromGracePeriodQueue(ctx, name, oldExpiryTime)
 	k.InsertExpiredNameInfoQueue(ctx, name, nameInfo.ExpiryTime)
 
+	if inGracePeriod {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRedeemName,
+				sdk.NewAttribute(types.AttributeKeyName, name),
+				sdk.NewAttribute(types.AttributeKeyPrice, fee.String()),
+			),
+		)
+	}
+
 	k.SetNameInfo(ctx, name, nameInfo)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -133,24 +166,37 @@ func (k Keeper) OwnsAnyName(ctx sdk.Context, owner sdk.AccAddress) bool {
 	return false
 }
 
-func (k Keeper) HandleDeleteName(ctx sdk.Context, name string, owner sdk.AccAddress) error {
+func (k Keeper) HandleDeleteName(ctx sdk.Context, name string, sender sdk.AccAddress) error {
 	nameInfo, found := k.GetNameInfo(ctx, name)
 	if ! found {
 		return types.ErrNameNotRegistered
 	}
 
-	if ! owner.Equals(nameInfo.Owner) {
+	if ! k.CanTransferName(ctx, nameInfo, sender) {
 		return types.ErrNotOwner
 	}
 
+	owner := nameInfo.Owner
+
+	if k.HasAuction(ctx, name) {
+		return types.ErrAuctionInProgress
+	}
+
 	k.RemoveFromExpiredNameInfoQueue(ctx, nameInfo.Name, nameInfo.ExpiryTime)
+	k.RemoveFromGracePeriodQueue(ctx, nameInfo.Name, nameInfo.ExpiryTime)
 
 	k.DeleteNameInfo(ctx, name)
 	k.DeleteNameInfoStatusMap(ctx, owner, name)
+	k.RemoveSubnames(ctx, name)
+	k.RemoveTextRecords(ctx, name)
+	k.RemoveNameAddresses(ctx, name)
+	k.DeleteNameApproval(ctx, name)
 
 	// if last HRA remove all associated addresses
 	if ! k.OwnsAnyName(ctx, owner) {
-		k.RemoveAllAddresses(ctx, owner)
+		if ! k.OwnsAnySubname(ctx, owner) {
+			k.RemoveAllAddresses(ctx, owner)
+		}
 
 		k.SetCredits(ctx, owner, sdk.ZeroInt())
 		err := k.AfterLastNameRemoved(ctx, owner)
@@ -162,20 +208,30 @@ func (k Keeper) HandleDeleteName(ctx sdk.Context, name string, owner sdk.AccAddr
 	return nil
 }
 
-func (k Keeper) HandleTransferName(ctx sdk.Context, name string, owner sdk.AccAddress, newOwner sdk.AccAddress) error {
+func (k Keeper) HandleTransferName(ctx sdk.Context, name string, sender sdk.AccAddress, newOwner sdk.AccAddress) error {
 	nameInfo, found := k.GetNameInfo(ctx, name)
 	if ! found {
 		return types.ErrNameNotRegistered
 	}
 
-	if ! owner.Equals(nameInfo.Owner) {
+	if ! k.CanTransferName(ctx, nameInfo, sender) {
 		return types.ErrNotOwner
 	}
 
+	owner := nameInfo.Owner
+
 	if nameInfo.Owner.Equals(newOwner) {
 		return types.ErrAlreadyOwned
 	}
 
+	if nameInfo.IsExpired(ctx.BlockTime()) {
+		return types.ErrNameInGracePeriod
+	}
+
+	if k.HasAuction(ctx, name) {
+		return types.ErrAuctionInProgress
+	}
+
 	account := k.AccountKeeper.GetAccount(ctx, newOwner)
 	if account == nil {
 		account = k.AccountKeeper.NewAccountWithAddress(ctx, newOwner)
@@ -190,6 +246,10 @@ func (k Keeper) HandleTransferName(ctx sdk.Context, name string, owner sdk.AccAd
 	// update the status mapping
 	k.DeleteNameInfoStatusMap(ctx, nameInfo.Owner, name)
 	k.SetNameInfoStatusMap(ctx, newOwner, name)
+	k.RemoveSubnames(ctx, name)
+	k.RemoveTextRecords(ctx, name)
+	k.RemoveNameAddresses(ctx, name)
+	k.DeleteNameApproval(ctx, name)
 
 	// update the owner and reset the price
 	nameInfo.Owner = newOwner
@@ -198,7 +258,9 @@ func (k Keeper) HandleTransferName(ctx sdk.Context, name string, owner sdk.AccAd
 	k.SetNameInfo(ctx, name, nameInfo)
 
 	if ! k.OwnsAnyName(ctx, owner) {
-		k.RemoveAllAddresses(ctx, owner)
+		if ! k.OwnsAnySubname(ctx, owner) {
+			k.RemoveAllAddresses(ctx, owner)
+		}
 		k.SetCredits(ctx, owner, sdk.ZeroInt())
 		err := k.AfterLastNameRemoved(ctx, owner)
 		if err != nil {
@@ -43,6 +43,18 @@ func (k Keeper) AddressRegistrationFee(ctx sdk.Context) (res sdk.Coins) {
 	return
 }
 
+// NameInfoGracePeriod
+func (k Keeper) NameInfoGracePeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyNameInfoGracePeriod, &res)
+	return
+}
+
+// NameInfoRedemptionFee
+func (k Keeper) NameInfoRedemptionFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramspace.Get(ctx, types.KeyNameInfoRedemptionFee, &res)
+	return
+}
+
 // GetParams returns the total set of hra parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
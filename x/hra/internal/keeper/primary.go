@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/x/hra/internal/types"
+)
+
+func (k Keeper) HandleSetPrimaryName(ctx sdk.Context, name string, owner sdk.AccAddress) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! owner.Equals(nameInfo.Owner) {
+		return types.ErrNotOwner
+	}
+
+	if nameInfo.IsExpired(ctx.BlockTime()) {
+		return types.ErrNameInGracePeriod
+	}
+
+	k.SetPrimaryName(ctx, owner, name)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetPrimaryName,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) GetPrimaryName(ctx sdk.Context, address sdk.AccAddress) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetPrimaryNameKey(address))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+func (k Keeper) SetPrimaryName(ctx sdk.Context, address sdk.AccAddress, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetPrimaryNameKey(address), []byte(name))
+}
+
+func (k Keeper) DeletePrimaryName(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetPrimaryNameKey(address))
+}
+
+func (k Keeper) GetPrimaryNamesIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.PrimaryNameKeyPrefix)
+}
+
+func (k Keeper) IteratePrimaryNames(ctx sdk.Context, cb func(primaryName types.PrimaryNameInfo) (stop bool)) {
+	iterator := k.GetPrimaryNamesIterator(ctx)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		address := sdk.AccAddress(iterator.Key()[1:])
+
+		if cb(types.NewPrimaryNameInfo(address, string(iterator.Value()))) {
+			break
+		}
+	}
+}
@@ -19,6 +19,14 @@ const (
 	QueryBlockchainAddresses = "blockchain-addresses"
 	QueryParameters = "parameters"
 	QueryModule = "module"
+	QueryAuction = "auction"
+	QueryAuctions = "auctions"
+	QueryNamesInGracePeriod = "grace-period"
+	QuerySubname = "subname"
+	QuerySubnames = "subnames"
+	QueryReverse = "reverse"
+	QueryBlockchainIdValidators = "blockchain-id-validators"
+	QueryApprovals = "approvals"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -42,6 +50,22 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryParams(ctx, k)
 		case QueryModule:
 			return queryModuleAccount(ctx, path[1:], req, k)
+		case QueryAuction:
+			return queryAuction(ctx, path[1:], req, k)
+		case QueryAuctions:
+			return queryAuctions(ctx, k)
+		case QueryNamesInGracePeriod:
+			return queryNamesInGracePeriod(ctx, path[1:], req, k)
+		case QuerySubname:
+			return querySubname(ctx, path[1:], req, k)
+		case QuerySubnames:
+			return querySubnames(ctx, path[1:], req, k)
+		case QueryReverse:
+			return queryReverse(ctx, path[1:], req, k)
+		case QueryBlockchainIdValidators:
+			return queryBlockchainIdValidators(ctx, k)
+		case QueryApprovals:
+			return queryApprovals(ctx, path[1:], req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Unknown hra query endpoint: %s", path[0])
 		}
@@ -54,12 +78,17 @@ func queryName(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper)
 		return nil, types.ErrNameNotRegistered
 	}
 
+	if nameInfo.IsExpired(ctx.BlockTime()) {
+		return nil, types.ErrNameInGracePeriod
+	}
+
 	resNameInfo := types.QueryResNameInfo{
 		NameInfo: nameInfo,
 		Credits:  k.GetCredits(ctx, nameInfo.Owner),
+		TextRecords: k.GetTextRecords(ctx, nameInfo.Name),
 	}
 
-	k.IterateBlockchainAddressInfos(ctx, nameInfo.Owner, func (info types.BlockchainAddressInfo) (stop bool) {
+	k.IterateResolvedAddresses(ctx, nameInfo.Name, nameInfo.Owner, func (info types.BlockchainAddressInfo) (stop bool) {
 		resNameInfo.Addresses = append(resNameInfo.Addresses, info)
 
 		return false
@@ -139,12 +168,36 @@ func queryAddressNameInfos(ctx sdk.Context, path []string, req abci.RequestQuery
 }
 
 func queryNameAddress(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var owner sdk.AccAddress
+
 	nameInfo, found := k.GetNameInfo(ctx, path[0])
-	if ! found {
-		return nil, types.ErrNameNotRegistered
+	if found {
+		if nameInfo.IsExpired(ctx.BlockTime()) {
+			return nil, types.ErrNameInGracePeriod
+		}
+
+		owner = nameInfo.Owner
+	} else {
+		if ! k.HasSubname(ctx, path[0]) {
+			return nil, types.ErrNameNotRegistered
+		}
+
+		// subnames resolve to the addresses of the account they are assigned to
+		subname, _, err := k.ResolveSubname(ctx, path[0])
+		if err != nil {
+			return nil, err
+		}
+
+		owner = subname.Owner
+	}
+
+	// without an index the default record of the blockchain id is resolved
+	index := ""
+	if len(path) > 2 {
+		index = path[2]
 	}
 
-	address, err := k.GetAddress(ctx, nameInfo.Owner, path[1], path[2])
+	address, err := k.ResolveAddress(ctx, path[0], owner, path[1], index)
 	if err != nil {
 		return nil, err
 	}
@@ -225,6 +278,139 @@ func queryModuleAccount(ctx sdk.Context, path []string, req abci.RequestQuery, k
 	return res, nil
 }
 
+func queryAuction(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	auction, found := k.GetAuction(ctx, path[0])
+	if ! found {
+		return nil, types.ErrAuctionNotFound
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, auction)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAuctions(ctx sdk.Context, k Keeper) ([]byte, error) {
+	auctions := types.QueryResAuctions(k.GetAuctions(ctx))
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, auctions)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryNamesInGracePeriod returns expired names which can still be redeemed, optionally filtered by owner
+func queryNamesInGracePeriod(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var owner sdk.AccAddress
+	if len(path) > 0 && path[0] != "" {
+		address, err := sdk.AccAddressFromBech32(path[0])
+		if err != nil {
+			return nil, err
+		}
+		owner = address
+	}
+
+	var names types.QueryResNameInfoGracePeriods
+
+	k.IterateAllGracePeriodQueue(ctx, func(name string) (stop bool) {
+		nameInfo, found := k.GetNameInfo(ctx, name)
+		if ! found {
+			return false
+		}
+
+		if owner.Empty() || owner.Equals(nameInfo.Owner) {
+			names = append(names, types.NewNameInfoGracePeriod(nameInfo, k.GracePeriodEnd(ctx, nameInfo)))
+		}
+
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, names)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func querySubname(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	subname, parentInfo, err := k.ResolveSubname(ctx, path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res, marshalErr := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryResSubname{
+		Subname:    subname,
+		ExpiryTime: parentInfo.ExpiryTime,
+	})
+	if marshalErr != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, marshalErr.Error())
+	}
+
+	return res, nil
+}
+
+func querySubnames(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	if ! k.IsNameRegistered(ctx, path[0]) {
+		return nil, types.ErrNameNotRegistered
+	}
+
+	subnames := types.QueryResSubnames{}
+
+	k.IterateSubnamesByParent(ctx, path[0], func(subname types.Subname) (stop bool) {
+		subnames = append(subnames, subname)
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, subnames)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryReverse(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	name, found := k.GetPrimaryName(ctx, address)
+	if ! found {
+		return nil, types.ErrPrimaryNameNotSet
+	}
+
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return nil, types.ErrPrimaryNameNotSet
+	}
+
+	if nameInfo.IsExpired(ctx.BlockTime()) {
+		return nil, types.ErrNameInGracePeriod
+	}
+
+	res, marshalErr := codec.MarshalJSONIndent(types.ModuleCdc, types.NewPrimaryNameInfo(address, name))
+	if marshalErr != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, marshalErr.Error())
+	}
+
+	return res, nil
+}
+
+func queryBlockchainIdValidators(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryResBlockchainIdValidators(k.GetBlockchainIdValidators(ctx)))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
 	params := k.GetParams(ctx)
 
@@ -234,4 +420,39 @@ func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
 	}
 
 	return res, nil
-}
\ No newline at end of file
+}
+
+// queryApprovals lists the operators approved by an address, for all of its names and for single names
+func queryApprovals(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := types.QueryResApprovals{}
+
+	k.IterateOperatorApprovalsByOwner(ctx, address, func(approval types.Approval) (stop bool) {
+		approvals = append(approvals, approval)
+		return false
+	})
+
+	iterator := k.GetNamesByAddressIterator(ctx, address)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		name := string(iterator.Key()[22:])
+
+		approval, found := k.GetNameApproval(ctx, name)
+		if found && approval.Owner.Equals(address) {
+			approvals = append(approvals, approval)
+		}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, approvals)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
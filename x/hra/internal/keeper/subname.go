@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/x/hra/internal/types"
+)
+
+func (k Keeper) HandleCreateSubname(ctx sdk.Context, name string, owner sdk.AccAddress, subnameOwner sdk.AccAddress) error {
+	_, parent, _ := types.SplitSubname(name)
+
+	parentInfo, found := k.GetNameInfo(ctx, parent)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! owner.Equals(parentInfo.Owner) {
+		return types.ErrNotOwner
+	}
+
+	if parentInfo.IsExpired(ctx.BlockTime()) {
+		return types.ErrNameInGracePeriod
+	}
+
+	if k.IsNameRegistered(ctx, name) {
+		return types.ErrNameRegistered
+	}
+
+	subname, found := k.GetSubname(ctx, name)
+	if found {
+		if subname.Owner.Equals(subnameOwner) {
+			return types.ErrAlreadyOwned
+		}
+
+		// reassign the subname to the new account
+		k.RemoveSubname(ctx, subname)
+	}
+
+	account := k.AccountKeeper.GetAccount(ctx, subnameOwner)
+	if account == nil {
+		account = k.AccountKeeper.NewAccountWithAddress(ctx, subnameOwner)
+		k.AccountKeeper.SetAccount(ctx, account)
+	}
+
+	k.SetSubname(ctx, types.NewSubname(name, parent, subnameOwner, ctx.BlockTime()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateSubname,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyParent, parent),
+			sdk.NewAttribute(types.AttributeKeyOwner, subnameOwner.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) HandleRevokeSubname(ctx sdk.Context, name string, owner sdk.AccAddress) error {
+	subname, found := k.GetSubname(ctx, name)
+	if ! found {
+		return types.ErrSubnameNotFound
+	}
+
+	parentInfo, found := k.GetNameInfo(ctx, subname.Parent)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! owner.Equals(parentInfo.Owner) {
+		return types.ErrNotOwner
+	}
+
+	k.RemoveSubname(ctx, subname)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeSubname,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyParent, subname.Parent),
+			sdk.NewAttribute(types.AttributeKeyOwner, subname.Owner.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+// RemoveSubnames removes all subnames of a parent name, used when the parent is deleted, expires or changes owner
+func (k Keeper) RemoveSubnames(ctx sdk.Context, parent string) {
+	var subnames []types.Subname
+
+	k.IterateSubnamesByParent(ctx, parent, func(subname types.Subname) (stop bool) {
+		subnames = append(subnames, subname)
+		return false
+	})
+
+	for _, subname := range subnames {
+		k.RemoveSubname(ctx, subname)
+	}
+}
+
+func (k Keeper) RemoveSubname(ctx sdk.Context, subname types.Subname) {
+	k.DeleteSubname(ctx, subname)
+
+	// subname holders without any HRA lose their addresses with their last subname
+	if ! k.OwnsAnyName(ctx, subname.Owner) && ! k.OwnsAnySubname(ctx, subname.Owner) {
+		k.RemoveAllAddresses(ctx, subname.Owner)
+	}
+}
+
+func (k Keeper) GetSubname(ctx sdk.Context, name string) (types.Subname, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetSubnameKey(name))
+	if bz == nil {
+		return types.Subname{}, false
+	}
+
+	var subname types.Subname
+	k.cdc.MustUnmarshalBinaryBare(bz, &subname)
+
+	return subname, true
+}
+
+func (k Keeper) SetSubname(ctx sdk.Context, subname types.Subname) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetSubnameKey(subname.Name), k.cdc.MustMarshalBinaryBare(subname))
+	store.Set(types.GetSubnameByParentKey(subname.Parent, subname.Name), types.StatusPresent)
+	store.Set(types.GetSubnameByOwnerKey(subname.Owner, subname.Name), types.StatusPresent)
+}
+
+func (k Keeper) DeleteSubname(ctx sdk.Context, subname types.Subname) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetSubnameKey(subname.Name))
+	store.Delete(types.GetSubnameByParentKey(subname.Parent, subname.Name))
+	store.Delete(types.GetSubnameByOwnerKey(subname.Owner, subname.Name))
+}
+
+func (k Keeper) HasSubname(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetSubnameKey(name))
+}
+
+func (k Keeper) OwnsAnySubname(ctx sdk.Context, owner sdk.AccAddress) bool {
+	iterator := k.GetSubnamesByOwnerIterator(ctx, owner)
+
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// ResolveSubname returns the subname together with its parent, which determines the subname expiry
+func (k Keeper) ResolveSubname(ctx sdk.Context, name string) (types.Subname, types.NameInfo, error) {
+	subname, found := k.GetSubname(ctx, name)
+	if ! found {
+		return types.Subname{}, types.NameInfo{}, types.ErrSubnameNotFound
+	}
+
+	parentInfo, found := k.GetNameInfo(ctx, subname.Parent)
+	if ! found {
+		return types.Subname{}, types.NameInfo{}, types.ErrNameNotRegistered
+	}
+
+	if parentInfo.IsExpired(ctx.BlockTime()) {
+		return types.Subname{}, types.NameInfo{}, types.ErrNameInGracePeriod
+	}
+
+	return subname, parentInfo, nil
+}
+
+func (k Keeper) GetSubnamesByParentIterator(ctx sdk.Context, parent string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetSubnameByParentIteratorKey(parent))
+}
+
+func (k Keeper) IterateSubnamesByParent(ctx sdk.Context, parent string, cb func(subname types.Subname) (stop bool)) {
+	iterator := k.GetSubnamesByParentIterator(ctx, parent)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		subname, found := k.GetSubname(ctx, types.SplitSubnameByParentKey(iterator.Key()))
+		if ! found {
+			continue
+		}
+
+		if cb(subname) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetSubnamesByOwnerIterator(ctx sdk.Context, owner sdk.AccAddress) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetSubnameByOwnerIteratorKey(owner))
+}
+
+func (k Keeper) GetSubnamesIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.SubnameKeyPrefix)
+}
+
+func (k Keeper) IterateSubnames(ctx sdk.Context, cb func(subname types.Subname) (stop bool)) {
+	iterator := k.GetSubnamesIterator(ctx)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var subname types.Subname
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &subname)
+
+		if cb(subname) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetSubnames(ctx sdk.Context) []types.Subname {
+	subnames := make([]types.Subname, 0)
+
+	k.IterateSubnames(ctx, func(subname types.Subname) (stop bool) {
+		subnames = append(subnames, subname)
+		return false
+	})
+
+	return subnames
+}
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/x/hra/internal/types"
+)
+
+func (k Keeper) HandleSetTextRecord(ctx sdk.Context, name string, owner sdk.AccAddress, key string, value string) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! k.CanManageName(ctx, nameInfo, owner) {
+		return types.ErrNotOwner
+	}
+
+	if nameInfo.IsExpired(ctx.BlockTime()) {
+		return types.ErrNameInGracePeriod
+	}
+
+	if ! k.HasTextRecord(ctx, name, key) && k.GetTextRecordsCount(ctx, name) >= types.MaxTextRecords {
+		return types.ErrTooManyTextRecords
+	}
+
+	err := k.ChargeRecordFee(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	k.SetTextRecord(ctx, name, types.NewTextRecord(key, value))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetTextRecord,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyRecordKey, key),
+			sdk.NewAttribute(types.AttributeKeyRecordValue, value),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) HandleRemoveTextRecord(ctx sdk.Context, name string, owner sdk.AccAddress, key string) error {
+	nameInfo, found := k.GetNameInfo(ctx, name)
+	if ! found {
+		return types.ErrNameNotRegistered
+	}
+
+	if ! k.CanManageName(ctx, nameInfo, owner) {
+		return types.ErrNotOwner
+	}
+
+	if ! k.HasTextRecord(ctx, name, key) {
+		return types.ErrTextRecordNotFound
+	}
+
+	k.DeleteTextRecord(ctx, name, key)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveTextRecord,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyRecordKey, key),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) SetTextRecord(ctx sdk.Context, name string, record types.TextRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetTextRecordKey(name, record.Key), []byte(record.Value))
+}
+
+func (k Keeper) DeleteTextRecord(ctx sdk.Context, name string, key string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetTextRecordKey(name, key))
+}
+
+func (k Keeper) HasTextRecord(ctx sdk.Context, name string, key string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetTextRecordKey(name, key))
+}
+
+// RemoveTextRecords clears the profile of a name, used when the name changes owner or is removed
+func (k Keeper) RemoveTextRecords(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := k.GetTextRecordsIterator(ctx, name)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
+
+func (k Keeper) GetTextRecordsIterator(ctx sdk.Context, name string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.GetTextRecordIteratorKey(name))
+}
+
+func (k Keeper) GetTextRecords(ctx sdk.Context, name string) []types.TextRecord {
+	records := make([]types.TextRecord, 0)
+
+	iterator := k.GetTextRecordsIterator(ctx, name)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, key := types.SplitTextRecordKey(iterator.Key())
+
+		records = append(records, types.NewTextRecord(key, string(iterator.Value())))
+	}
+
+	return records
+}
+
+func (k Keeper) GetTextRecordsCount(ctx sdk.Context, name string) int {
+	iterator := k.GetTextRecordsIterator(ctx, name)
+	defer iterator.Close()
+
+	count := 0
+
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+
+	return count
+}
+
+func (k Keeper) IterateAllTextRecords(ctx sdk.Context, cb func(record types.NameTextRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TextRecordKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		name, key := types.SplitTextRecordKey(iterator.Key())
+
+		if cb(types.NewNameTextRecord(name, types.NewTextRecord(key, string(iterator.Value())))) {
+			break
+		}
+	}
+}
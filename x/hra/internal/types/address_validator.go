@@ -0,0 +1,465 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	sdkerrors "github.com/DFWallet/anatha/types/errors"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	AddressValidatorGeneric     = "generic"
+	AddressValidatorBitcoin     = "bitcoin"
+	AddressValidatorLitecoin    = "litecoin"
+	AddressValidatorBitcoinCash = "bitcoin-cash"
+	AddressValidatorDash        = "dash"
+	AddressValidatorEthereum    = "ethereum"
+	AddressValidatorCosmos      = "cosmos"
+	AddressValidatorBinance     = "binance"
+	AddressValidatorRipple      = "ripple"
+
+	base58BitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	base58RippleAlphabet  = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+
+	bech32Charset   = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	bech32Constant  = 1
+	bech32mConstant = 0x2bc830a3
+	bech32MaxLen    = 90
+)
+
+// AddressValidator checks the format of an address on an external blockchain
+type AddressValidator func(address string) error
+
+var (
+	addressValidators = map[string]AddressValidator{
+		AddressValidatorGeneric:     validateBlockchainAddress,
+		AddressValidatorBitcoin:     validateBitcoinAddress,
+		AddressValidatorLitecoin:    validateLitecoinAddress,
+		AddressValidatorBitcoinCash: validateBitcoinCashAddress,
+		AddressValidatorDash:        validateDashAddress,
+		AddressValidatorEthereum:    validateEthereumAddress,
+		AddressValidatorCosmos:      validateCosmosAddress,
+		AddressValidatorBinance:     validateBinanceAddress,
+		AddressValidatorRipple:      validateRippleAddress,
+	}
+
+	// DefaultBlockchainIdValidators assigns the built-in validators to DefaultRegisteredBlockchainIds
+	DefaultBlockchainIdValidators = []BlockchainIdValidator{
+		{BlockchainId: "omg", AddressValidator: AddressValidatorEthereum},
+		{BlockchainId: "tusd", AddressValidator: AddressValidatorEthereum},
+		{BlockchainId: "zrx", AddressValidator: AddressValidatorEthereum},
+		{BlockchainId: "btc", AddressValidator: AddressValidatorBitcoin},
+		{BlockchainId: "bch", AddressValidator: AddressValidatorBitcoinCash},
+		{BlockchainId: "eth", AddressValidator: AddressValidatorEthereum},
+		{BlockchainId: "dash", AddressValidator: AddressValidatorDash},
+		{BlockchainId: "ltc", AddressValidator: AddressValidatorLitecoin},
+		{BlockchainId: "atom", AddressValidator: AddressValidatorCosmos},
+		{BlockchainId: "xrp", AddressValidator: AddressValidatorRipple},
+		{BlockchainId: "bnb", AddressValidator: AddressValidatorBinance},
+	}
+
+	validHexAddress = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`).MatchString
+)
+
+// RegisterAddressValidator makes a validator available to RegisterBlockchainIdProposal. Has to be called on init.
+func RegisterAddressValidator(name string, validator AddressValidator) {
+	if _, ok := addressValidators[name]; ok {
+		panic(fmt.Sprintf("address validator %s already registered", name))
+	}
+
+	addressValidators[name] = validator
+}
+
+func GetAddressValidator(name string) (AddressValidator, bool) {
+	validator, ok := addressValidators[name]
+	return validator, ok
+}
+
+func GetAddressValidatorNames() []string {
+	names := make([]string, 0, len(addressValidators))
+	for name := range addressValidators {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func validateAddressValidatorName(name string) error {
+	if _, ok := addressValidators[name]; ! ok {
+		return sdkerrors.Wrapf(ErrAddressValidatorNotValid, "unknown address validator %s, available: %s", name, strings.Join(GetAddressValidatorNames(), ", "))
+	}
+
+	return nil
+}
+
+func validateBitcoinAddress(address string) error {
+	if strings.HasPrefix(strings.ToLower(address), "bc1") {
+		return validateSegwitAddress(address, "bc")
+	}
+
+	return validateBase58CheckAddress(address, base58BitcoinAlphabet, 0x00, 0x05)
+}
+
+func validateLitecoinAddress(address string) error {
+	if strings.HasPrefix(strings.ToLower(address), "ltc1") {
+		return validateSegwitAddress(address, "ltc")
+	}
+
+	return validateBase58CheckAddress(address, base58BitcoinAlphabet, 0x30, 0x32, 0x05)
+}
+
+func validateBitcoinCashAddress(address string) error {
+	if validateBase58CheckAddress(address, base58BitcoinAlphabet, 0x00, 0x05) == nil {
+		return nil
+	}
+
+	return validateCashAddress(address, "bitcoincash")
+}
+
+func validateDashAddress(address string) error {
+	return validateBase58CheckAddress(address, base58BitcoinAlphabet, 0x4c, 0x10)
+}
+
+func validateRippleAddress(address string) error {
+	return validateBase58CheckAddress(address, base58RippleAlphabet, 0x00)
+}
+
+func validateCosmosAddress(address string) error {
+	return validateBech32Address(address, "cosmos", 20, 32)
+}
+
+func validateBinanceAddress(address string) error {
+	return validateBech32Address(address, "bnb", 20)
+}
+
+// validateEthereumAddress accepts all lower or all upper case hex addresses and EIP-55 checksummed mixed case addresses
+func validateEthereumAddress(address string) error {
+	if ! validHexAddress(address) {
+		return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "address has to be 0x followed by 40 hex characters")
+	}
+
+	hex := address[2:]
+	if hex == strings.ToLower(hex) || hex == strings.ToUpper(hex) {
+		return nil
+	}
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(strings.ToLower(hex)))
+	digest := hash.Sum(nil)
+
+	for i, c := range hex {
+		if ! unicode.IsLetter(c) {
+			continue
+		}
+
+		nibble := digest[i / 2]
+		if i % 2 == 0 {
+			nibble = nibble >> 4
+		} else {
+			nibble = nibble & 0x0f
+		}
+
+		if (nibble >= 8) != unicode.IsUpper(c) {
+			return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid EIP-55 checksum")
+		}
+	}
+
+	return nil
+}
+
+func validateBase58CheckAddress(address string, alphabet string, versions ...byte) error {
+	decoded, err := decodeBase58(address, alphabet)
+	if err != nil {
+		return err
+	}
+
+	// version byte, 20 byte hash and 4 byte checksum
+	if len(decoded) != 25 {
+		return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid address length")
+	}
+
+	first := sha256.Sum256(decoded[:21])
+	second := sha256.Sum256(first[:])
+	if ! bytes.Equal(second[:4], decoded[21:]) {
+		return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid base58 checksum")
+	}
+
+	for _, version := range versions {
+		if decoded[0] == version {
+			return nil
+		}
+	}
+
+	return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid address version")
+}
+
+func decodeBase58(address string, alphabet string) ([]byte, error) {
+	if len(address) == 0 {
+		return nil, sdkerrors.Wrap(ErrBlockchainAddressNotValid, "address is required")
+	}
+
+	value := new(big.Int)
+	radix := big.NewInt(58)
+
+	for _, c := range address {
+		index := strings.IndexRune(alphabet, c)
+		if index < 0 {
+			return nil, sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid base58 character")
+		}
+
+		value.Mul(value, radix)
+		value.Add(value, big.NewInt(int64(index)))
+	}
+
+	// leading zero bytes are encoded as the first alphabet character
+	zeros := 0
+	for zeros < len(address) && address[zeros] == alphabet[0] {
+		zeros++
+	}
+
+	return append(make([]byte, zeros), value.Bytes()...), nil
+}
+
+func validateSegwitAddress(address string, hrp string) error {
+	decodedHrp, data, constant, err := decodeBech32(address)
+	if err != nil {
+		return err
+	}
+
+	if decodedHrp != hrp {
+		return sdkerrors.Wrapf(ErrBlockchainAddressNotValid, "address has to start with %s1", hrp)
+	}
+
+	if len(data) < 1 || data[0] > 16 {
+		return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid witness version")
+	}
+
+	program, err := convertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return err
+	}
+
+	if len(program) < 2 || len(program) > 40 {
+		return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid witness program length")
+	}
+
+	if data[0] == 0 {
+		if len(program) != 20 && len(program) != 32 {
+			return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid witness program length")
+		}
+		if constant != bech32Constant {
+			return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "witness version 0 has to use bech32")
+		}
+	} else if constant != bech32mConstant {
+		return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "witness version 1+ has to use bech32m")
+	}
+
+	return nil
+}
+
+func validateBech32Address(address string, hrp string, lengths ...int) error {
+	decodedHrp, data, constant, err := decodeBech32(address)
+	if err != nil {
+		return err
+	}
+
+	if decodedHrp != hrp || constant != bech32Constant {
+		return sdkerrors.Wrapf(ErrBlockchainAddressNotValid, "address has to start with %s1", hrp)
+	}
+
+	decoded, err := convertBits(data, 5, 8, false)
+	if err != nil {
+		return err
+	}
+
+	for _, length := range lengths {
+		if len(decoded) == length {
+			return nil
+		}
+	}
+
+	return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid address length")
+}
+
+// decodeBech32 returns the human readable part, the data without the checksum and the checksum constant (BIP-173 or BIP-350)
+func decodeBech32(address string) (string, []byte, uint32, error) {
+	if len(address) > bech32MaxLen {
+		return "", nil, 0, sdkerrors.Wrap(ErrBlockchainAddressNotValid, "address too long")
+	}
+
+	lower := strings.ToLower(address)
+	if address != lower && address != strings.ToUpper(address) {
+		return "", nil, 0, sdkerrors.Wrap(ErrBlockchainAddressNotValid, "mixed case address")
+	}
+
+	separator := strings.LastIndex(lower, "1")
+	if separator < 1 || separator + 7 > len(lower) {
+		return "", nil, 0, sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid bech32 separator position")
+	}
+
+	hrp := lower[:separator]
+	for _, c := range hrp {
+		if c < 33 || c > 126 {
+			return "", nil, 0, sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid bech32 prefix")
+		}
+	}
+
+	data := make([]byte, 0, len(lower) - separator - 1)
+	for _, c := range lower[separator + 1:] {
+		index := strings.IndexRune(bech32Charset, c)
+		if index < 0 {
+			return "", nil, 0, sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid bech32 character")
+		}
+		data = append(data, byte(index))
+	}
+
+	constant := bech32Polymod(append(bech32ExpandHrp(hrp), data...))
+	if constant != bech32Constant && constant != bech32mConstant {
+		return "", nil, 0, sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid bech32 checksum")
+	}
+
+	return hrp, data[:len(data) - 6], constant, nil
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generator := []uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+	checksum := uint32(1)
+	for _, value := range values {
+		top := checksum >> 25
+		checksum = (checksum & 0x1ffffff) << 5 ^ uint32(value)
+		for i := 0; i < 5; i++ {
+			if (top >> uint(i)) & 1 == 1 {
+				checksum ^= generator[i]
+			}
+		}
+	}
+
+	return checksum
+}
+
+func bech32ExpandHrp(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp) * 2 + 1)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i] >> 5)
+	}
+
+	expanded = append(expanded, 0)
+
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i] & 31)
+	}
+
+	return expanded
+}
+
+// validateCashAddress checks a bitcoin cash CashAddr address, the prefix is optional
+func validateCashAddress(address string, prefix string) error {
+	lower := strings.ToLower(address)
+	if address != lower && address != strings.ToUpper(address) {
+		return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "mixed case address")
+	}
+
+	if ! strings.Contains(lower, ":") {
+		lower = prefix + ":" + lower
+	}
+
+	parts := strings.SplitN(lower, ":", 2)
+	if parts[0] != prefix {
+		return sdkerrors.Wrapf(ErrBlockchainAddressNotValid, "address prefix has to be %s", prefix)
+	}
+
+	payload := make([]byte, 0, len(parts[1]))
+	for _, c := range parts[1] {
+		index := strings.IndexRune(bech32Charset, c)
+		if index < 0 {
+			return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid cashaddr character")
+		}
+		payload = append(payload, byte(index))
+	}
+
+	if len(payload) <= 8 {
+		return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid address length")
+	}
+
+	values := make([]byte, 0, len(prefix) + 1 + len(payload))
+	for i := 0; i < len(prefix); i++ {
+		values = append(values, prefix[i] & 0x1f)
+	}
+	values = append(values, 0)
+	values = append(values, payload...)
+
+	if cashAddrPolymod(values) != 0 {
+		return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid cashaddr checksum")
+	}
+
+	decoded, err := convertBits(payload[:len(payload) - 8], 5, 8, false)
+	if err != nil {
+		return err
+	}
+
+	// version byte with P2PKH or P2SH type and 160 bit hash size, followed by the hash
+	if len(decoded) != 21 || decoded[0] >> 3 > 1 || decoded[0] & 0x07 != 0 {
+		return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid cashaddr version")
+	}
+
+	return nil
+}
+
+func cashAddrPolymod(values []byte) uint64 {
+	generator := []uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+
+	checksum := uint64(1)
+	for _, value := range values {
+		top := byte(checksum >> 35)
+		checksum = (checksum & 0x07ffffffff) << 5 ^ uint64(value)
+		for i := 0; i < 5; i++ {
+			if (top >> uint(i)) & 1 == 1 {
+				checksum ^= generator[i]
+			}
+		}
+	}
+
+	return checksum ^ 1
+}
+
+func convertBits(data []byte, fromBits uint, toBits uint, pad bool) ([]byte, error) {
+	var result []byte
+
+	accumulator := uint32(0)
+	bits := uint(0)
+	maxValue := uint32(1 << toBits) - 1
+
+	for _, value := range data {
+		if uint32(value) >> fromBits != 0 {
+			return nil, sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid data range")
+		}
+
+		accumulator = accumulator << fromBits | uint32(value)
+		bits += fromBits
+
+		for bits >= toBits {
+			bits -= toBits
+			result = append(result, byte(accumulator >> bits & maxValue))
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			result = append(result, byte(accumulator << (toBits - bits) & maxValue))
+		}
+	} else if bits >= fromBits || accumulator << (toBits - bits) & maxValue != 0 {
+		return nil, sdkerrors.Wrap(ErrBlockchainAddressNotValid, "invalid padding")
+	}
+
+	return result, nil
+}
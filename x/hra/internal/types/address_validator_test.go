@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAddressValidators(t *testing.T) {
+	cases := []struct {
+		validator string
+		address   string
+		valid     bool
+	}{
+		{AddressValidatorBitcoin, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", true},
+		{AddressValidatorBitcoin, "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", true},
+		{AddressValidatorBitcoin, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", true},
+		{AddressValidatorBitcoin, "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", true},
+		{AddressValidatorBitcoin, "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", true},
+		{AddressValidatorBitcoin, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb", false},
+		{AddressValidatorBitcoin, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5", false},
+		{AddressValidatorBitcoin, "hello", false},
+
+		{AddressValidatorBitcoinCash, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", true},
+		{AddressValidatorBitcoinCash, "qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", true},
+		{AddressValidatorBitcoinCash, "1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu", true},
+		{AddressValidatorBitcoinCash, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6q", false},
+
+		{AddressValidatorEthereum, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true},
+		{AddressValidatorEthereum, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", true},
+		{AddressValidatorEthereum, "0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed", false},
+		{AddressValidatorEthereum, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beae", false},
+
+		{AddressValidatorCosmos, "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", true},
+		{AddressValidatorCosmos, "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xv", false},
+		{AddressValidatorBinance, "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", false},
+
+		{AddressValidatorRipple, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", true},
+		{AddressValidatorRipple, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTi", false},
+	}
+
+	for tcIndex, tc := range cases {
+		validator, ok := GetAddressValidator(tc.validator)
+		require.True(t, ok)
+
+		err := validator(tc.address)
+		if tc.valid {
+			require.NoError(t, err, "%s address %s should be valid, tc #%d", tc.validator, tc.address, tcIndex)
+		} else {
+			require.Error(t, err, "%s address %s should be invalid, tc #%d", tc.validator, tc.address, tcIndex)
+		}
+	}
+}
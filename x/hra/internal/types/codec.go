@@ -12,8 +12,23 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBuyName{}, "hra/Buy", nil)
 	cdc.RegisterConcrete(MsgTransferName{}, "hra/Transfer", nil)
 	cdc.RegisterConcrete(MsgRegisterAddress{}, "hra/RegisterAddress", nil)
+	cdc.RegisterConcrete(MsgRegisterAddressV2{}, "hra/RegisterAddressV2", nil)
+	cdc.RegisterConcrete(MsgSetAddresses{}, "hra/SetAddresses", nil)
 	cdc.RegisterConcrete(MsgRemoveAddress{}, "hra/RemoveAddress", nil)
 	cdc.RegisterConcrete(MsgRemoveAllAddresses{}, "hra/RemoveAllAddresses", nil)
+	cdc.RegisterConcrete(MsgCreateAuction{}, "hra/CreateAuction", nil)
+	cdc.RegisterConcrete(MsgPlaceBid{}, "hra/PlaceBid", nil)
+	cdc.RegisterConcrete(MsgCancelAuction{}, "hra/CancelAuction", nil)
+	cdc.RegisterConcrete(MsgCreateSubname{}, "hra/CreateSubname", nil)
+	cdc.RegisterConcrete(MsgRevokeSubname{}, "hra/RevokeSubname", nil)
+	cdc.RegisterConcrete(MsgSetPrimaryName{}, "hra/SetPrimaryName", nil)
+	cdc.RegisterConcrete(MsgSetTextRecord{}, "hra/SetTextRecord", nil)
+	cdc.RegisterConcrete(MsgRemoveTextRecord{}, "hra/RemoveTextRecord", nil)
+	cdc.RegisterConcrete(MsgRegisterNameAddress{}, "hra/RegisterNameAddress", nil)
+	cdc.RegisterConcrete(MsgRemoveNameAddress{}, "hra/RemoveNameAddress", nil)
+	cdc.RegisterConcrete(MsgApprove{}, "hra/Approve", nil)
+	cdc.RegisterConcrete(MsgRevokeApproval{}, "hra/RevokeApproval", nil)
+	cdc.RegisterConcrete(MsgSetApprovalForAll{}, "hra/SetApprovalForAll", nil)
 
 	cdc.RegisterConcrete(RegisterBlockchainIdProposal{}, "hra/RegisterBlockchainIdProposal", nil)
 	cdc.RegisterConcrete(RemoveBlockchainIdProposal{}, "hra/RemoveBlockchainIdProposal", nil)
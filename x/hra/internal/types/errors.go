@@ -19,4 +19,20 @@ var (
 	ErrBlockchainAddressNotFound 	= sdkerrors.Register(ModuleName, 111, "Blockchain Address not found.")
 	ErrNoNamesRegistered         	= sdkerrors.Register(ModuleName, 112, "No names registered.")
 	ErrMaximumDurationExceeded 		= sdkerrors.Register(ModuleName, 113, "Maximum Name Info duration exceeded.")
+	ErrInvalidTime 					= sdkerrors.Register(ModuleName, 114, "Invalid time.")
+	ErrAuctionInProgress 			= sdkerrors.Register(ModuleName, 115, "Name is being auctioned.")
+	ErrAuctionNotFound 				= sdkerrors.Register(ModuleName, 116, "Auction not found.")
+	ErrAuctionEnded 				= sdkerrors.Register(ModuleName, 117, "Auction has ended.")
+	ErrBidTooLow 					= sdkerrors.Register(ModuleName, 118, "Bid is too low.")
+	ErrAuctionHasBids 				= sdkerrors.Register(ModuleName, 119, "Auction already has bids.")
+	ErrNameInGracePeriod 			= sdkerrors.Register(ModuleName, 120, "Name is expired and in the grace period.")
+	ErrSubnameNotFound 				= sdkerrors.Register(ModuleName, 121, "Subname not found.")
+	ErrSubnameReserved 				= sdkerrors.Register(ModuleName, 122, "Name is reserved as a subname of a registered name.")
+	ErrPrimaryNameNotSet 			= sdkerrors.Register(ModuleName, 123, "Primary name is not set.")
+	ErrAddressValidatorNotValid 	= sdkerrors.Register(ModuleName, 124, "Address validator not valid.")
+	ErrTextRecordNotValid 			= sdkerrors.Register(ModuleName, 125, "Text record not valid.")
+	ErrTextRecordNotFound 			= sdkerrors.Register(ModuleName, 126, "Text record not found.")
+	ErrTooManyTextRecords 			= sdkerrors.Register(ModuleName, 127, "Maximum number of text records reached.")
+	ErrApprovalNotFound 			= sdkerrors.Register(ModuleName, 128, "Approval not found.")
+	ErrInvalidOperator 				= sdkerrors.Register(ModuleName, 129, "Owner can not approve itself as an operator.")
 )
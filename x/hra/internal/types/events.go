@@ -9,7 +9,24 @@ const (
 	EventTypeTransferName		= "transfer_name"
 	EventTypeRegisterAddress 	= "register_address"
 	EventTypeRemoveAddress		= "remove_address"
+	EventTypeSetAddresses		= "set_addresses"
+	EventTypeRegisterNameAddress	= "register_name_address"
+	EventTypeRemoveNameAddress	= "remove_name_address"
 	EventTypeExpiredName 		= "expired_name"
+	EventTypeNameGracePeriod	= "name_grace_period"
+	EventTypeRedeemName			= "redeem_name"
+	EventTypeCreateSubname		= "create_subname"
+	EventTypeRevokeSubname		= "revoke_subname"
+	EventTypeSetPrimaryName		= "set_primary_name"
+	EventTypeSetTextRecord		= "set_text_record"
+	EventTypeRemoveTextRecord	= "remove_text_record"
+	EventTypeApprove			= "approve"
+	EventTypeRevokeApproval		= "revoke_approval"
+	EventTypeSetApprovalForAll	= "set_approval_for_all"
+	EventTypeCreateAuction		= "create_auction"
+	EventTypePlaceBid			= "place_bid"
+	EventTypeCancelAuction		= "cancel_auction"
+	EventTypeSettleAuction		= "settle_auction"
 	EventTypeRegisterBlockchainId = "RegisterBlockchainId"
 	EventTypeRemoveBlockchainId   = "RemoveBlockchainId"
 
@@ -23,6 +40,26 @@ const (
 	AttributeKeyIndex				= "index"
 	AttributeKeyTitle				= "title"
 	AttributeKeyDescription			= "description"
+	AttributeKeyReservePrice		= "reserve_price"
+	AttributeKeyEndTime				= "end_time"
+	AttributeKeyBidder				= "bidder"
+	AttributeKeyAmount				= "amount"
+	AttributeKeyWinner				= "winner"
+	AttributeKeyGracePeriodEnd		= "grace_period_end"
+	AttributeKeyParent				= "parent"
+	AttributeKeyOwner				= "owner"
+	AttributeKeyAddressValidator	= "address_validator"
+	AttributeKeyLabel				= "label"
+	AttributeKeyMemo				= "memo"
+	AttributeKeyDefault				= "default"
+	AttributeKeyRecordKey			= "record_key"
+	AttributeKeyRecordValue			= "record_value"
+	AttributeKeyCount				= "count"
+	AttributeKeyReplace				= "replace"
+	AttributeKeyAddresses			= "addresses"
+	AttributeKeyOperator			= "operator"
+	AttributeKeyCanTransfer			= "can_transfer"
+	AttributeKeyApproved			= "approved"
 
 	AttributeValueModule = ModuleName
 )
@@ -10,16 +10,32 @@ type GenesisState struct {
 	AddressRecords			[]BlockchainAddressRecordInfo `json:"address_records" yaml:"address_records"`
 	AddressCredits          []AddressCreditsInfo    `json:"address_credits" yaml:"address_credits"`
 	RegisteredBlockchainIds []string	`json:"registered_blockchain_ids" yaml:"registered_blockchain_ids"`
+	Auctions				[]Auction	`json:"auctions" yaml:"auctions"`
+	Subnames				[]Subname	`json:"subnames" yaml:"subnames"`
+	PrimaryNames			[]PrimaryNameInfo	`json:"primary_names" yaml:"primary_names"`
+	BlockchainIdValidators	[]BlockchainIdValidator	`json:"blockchain_id_validators" yaml:"blockchain_id_validators"`
+	TextRecords				[]NameTextRecord	`json:"text_records" yaml:"text_records"`
+	NameAddressRecords		[]NameBlockchainAddressInfo	`json:"name_address_records" yaml:"name_address_records"`
+	NameApprovals			[]Approval	`json:"name_approvals" yaml:"name_approvals"`
+	OperatorApprovals		[]Approval	`json:"operator_approvals" yaml:"operator_approvals"`
 }
 
 
-func NewGenesisState(params Params, nameRecords []NameInfo, addressRecords []BlockchainAddressRecordInfo, addressCredits []AddressCreditsInfo, registeredBlockchainIds []string) GenesisState {
+func NewGenesisState(params Params, nameRecords []NameInfo, addressRecords []BlockchainAddressRecordInfo, addressCredits []AddressCreditsInfo, registeredBlockchainIds []string, auctions []Auction, subnames []Subname, primaryNames []PrimaryNameInfo, blockchainIdValidators []BlockchainIdValidator, textRecords []NameTextRecord, nameAddressRecords []NameBlockchainAddressInfo, nameApprovals []Approval, operatorApprovals []Approval) GenesisState {
 	return GenesisState{
 		Params: params,
 		NameRecords: nameRecords,
 		AddressRecords: addressRecords,
 		AddressCredits: addressCredits,
 		RegisteredBlockchainIds: registeredBlockchainIds,
+		Auctions: auctions,
+		Subnames: subnames,
+		PrimaryNames: primaryNames,
+		BlockchainIdValidators: blockchainIdValidators,
+		TextRecords: textRecords,
+		NameAddressRecords: nameAddressRecords,
+		NameApprovals: nameApprovals,
+		OperatorApprovals: operatorApprovals,
 	}
 }
 
@@ -30,6 +46,14 @@ func DefaultGenesisState() GenesisState {
 		AddressRecords: []BlockchainAddressRecordInfo{},
 		AddressCredits: []AddressCreditsInfo{},
 		RegisteredBlockchainIds: DefaultRegisteredBlockchainIds,
+		Auctions: []Auction{},
+		Subnames: []Subname{},
+		PrimaryNames: []PrimaryNameInfo{},
+		BlockchainIdValidators: DefaultBlockchainIdValidators,
+		TextRecords: []NameTextRecord{},
+		NameAddressRecords: []NameBlockchainAddressInfo{},
+		NameApprovals: []Approval{},
+		OperatorApprovals: []Approval{},
 	}
 }
 
@@ -77,5 +101,96 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+	for _, record := range data.Auctions {
+		err := validateName(record.Name)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid Auction: Name: %s", record.Name)
+		}
+		if record.Owner.Empty() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid Auction: Name: %s. Error: Missing owner", record.Name)
+		}
+		if ! record.ReservePrice.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid Auction: Name: %s. Error: Invalid reserve price", record.Name)
+		}
+		if record.HasBids() && ! record.HighestBid.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid Auction: Name: %s. Error: Invalid highest bid", record.Name)
+		}
+	}
+	for _, record := range data.AddressRecords {
+		err := validateAddressMetadata(record.BlockchainAddressInfo.Metadata())
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid AddressRecord: Address: %s", record.Address)
+		}
+	}
+	for _, record := range data.Subnames {
+		err := validateSubname(record.Name)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid Subname: Name: %s", record.Name)
+		}
+		if _, parent, _ := SplitSubname(record.Name); parent != record.Parent {
+			return sdkerrors.Wrapf(ErrNameNotValid, "invalid Subname: Name: %s. Error: Parent mismatch", record.Name)
+		}
+		if record.Owner.Empty() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid Subname: Name: %s. Error: Missing owner", record.Name)
+		}
+	}
+	for _, record := range data.PrimaryNames {
+		if record.Address.Empty() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid PrimaryName: Name: %s. Error: Missing address", record.Name)
+		}
+		err := validateName(record.Name)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid PrimaryName: Name: %s", record.Name)
+		}
+	}
+	for _, record := range data.BlockchainIdValidators {
+		if err := validateBlockchainId(record.BlockchainId); err != nil {
+			return sdkerrors.Wrapf(err, "invalid BlockchainIdValidator: Blockchain Id: %s", record.BlockchainId)
+		}
+		if err := validateAddressValidatorName(record.AddressValidator); err != nil {
+			return sdkerrors.Wrapf(err, "invalid BlockchainIdValidator: Blockchain Id: %s", record.BlockchainId)
+		}
+	}
+	for _, record := range data.TextRecords {
+		if err := validateName(record.Name); err != nil {
+			return sdkerrors.Wrapf(err, "invalid TextRecord: Name: %s", record.Name)
+		}
+		if err := validateTextRecord(record.TextRecord); err != nil {
+			return sdkerrors.Wrapf(err, "invalid TextRecord: Name: %s", record.Name)
+		}
+	}
+	for _, record := range data.NameAddressRecords {
+		if err := validateName(record.Name); err != nil {
+			return sdkerrors.Wrapf(err, "invalid NameAddressRecord: Name: %s", record.Name)
+		}
+		if err := validateBlockchainId(record.BlockchainAddressInfo.BlockchainId); err != nil {
+			return sdkerrors.Wrapf(err, "invalid NameAddressRecord: Name: %s", record.Name)
+		}
+		if err := validateIndex(record.BlockchainAddressInfo.Index); err != nil {
+			return sdkerrors.Wrapf(err, "invalid NameAddressRecord: Name: %s", record.Name)
+		}
+		if err := validateBlockchainAddress(record.BlockchainAddressInfo.BlockchainAddress); err != nil {
+			return sdkerrors.Wrapf(err, "invalid NameAddressRecord: Name: %s", record.Name)
+		}
+		if err := validateAddressMetadata(record.BlockchainAddressInfo.Metadata()); err != nil {
+			return sdkerrors.Wrapf(err, "invalid NameAddressRecord: Name: %s", record.Name)
+		}
+	}
+	for _, record := range data.NameApprovals {
+		if err := validateName(record.Name); err != nil {
+			return sdkerrors.Wrapf(err, "invalid NameApproval: Name: %s", record.Name)
+		}
+		if record.Owner.Empty() || record.Operator.Empty() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid NameApproval: Name: %s. Error: Missing owner or operator", record.Name)
+		}
+	}
+	for _, record := range data.OperatorApprovals {
+		if ! record.IsForAll() {
+			return sdkerrors.Wrapf(ErrNameNotValid, "invalid OperatorApproval: Owner: %s. Error: Name must be empty", record.Owner)
+		}
+		if record.Owner.Empty() || record.Operator.Empty() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid OperatorApproval: Owner: %s. Error: Missing owner or operator", record.Owner)
+		}
+	}
 	return nil
 }
\ No newline at end of file
@@ -16,6 +16,8 @@ const (
 	QuerierRoute = ModuleName
 
 	Separator = ":"
+
+	AuctionEscrowModuleName = "hra_auction_escrow" // Module stores the bids of open name auctions
 )
 
 // Keys for HRA store
@@ -27,6 +29,19 @@ const (
 // - 0x13<Addr_Bytes><Separator><BlockchainId_Bytes><Separator><AddressIndex_Bytes>: BlockchainAddress
 // - 0x14<Addr_Bytes>: Int
 // - 0x15<BlockchainId_Bytes>: boolean
+// - 0x16<Name_Bytes>: Auction
+// - 0x17<endTime_Bytes><Name_Bytes>: Name
+// - 0x18<expiryTime_Bytes><Name_Bytes>: Name
+// - 0x19<Subname_Bytes>: Subname
+// - 0x1A<ParentName_Bytes><Separator><Subname_Bytes>: boolean
+// - 0x1B<Addr_Bytes><Separator><Subname_Bytes>: boolean
+// - 0x1C<Addr_Bytes>: Name
+// - 0x1D<BlockchainId_Bytes>: AddressValidator
+// - 0x1E<Addr_Bytes><Separator><BlockchainId_Bytes><Separator><AddressIndex_Bytes>: AddressMetadata
+// - 0x1F<Name_Bytes><Separator><RecordKey_Bytes>: Value
+// - 0x20<Name_Bytes><Separator><BlockchainId_Bytes><Separator><AddressIndex_Bytes>: BlockchainAddressInfo
+// - 0x21<Name_Bytes>: Approval
+// - 0x22<Owner_Addr_Bytes><Operator_Addr_Bytes>: Approval
 var (
 	NameInfoByNameKeyPrefix         = []byte{0x10}
 	StatusByAddressAndNameKeyPrefix = []byte{0x11}
@@ -34,6 +49,19 @@ var (
 	AddressKeyPrefix                = []byte{0x13}
 	CreditsKeyPrefix                = []byte{0x14}
 	RegisteredBlockchainIdKeyPrefix = []byte{0x15}
+	AuctionKeyPrefix                = []byte{0x16}
+	AuctionQueueKeyPrefix           = []byte{0x17}
+	GracePeriodQueueKeyPrefix       = []byte{0x18}
+	SubnameKeyPrefix                = []byte{0x19}
+	SubnameByParentKeyPrefix        = []byte{0x1A}
+	SubnameByOwnerKeyPrefix         = []byte{0x1B}
+	PrimaryNameKeyPrefix            = []byte{0x1C}
+	BlockchainIdValidatorKeyPrefix  = []byte{0x1D}
+	AddressMetadataKeyPrefix        = []byte{0x1E}
+	TextRecordKeyPrefix             = []byte{0x1F}
+	NameAddressKeyPrefix            = []byte{0x20}
+	NameApprovalKeyPrefix           = []byte{0x21}
+	OperatorApprovalKeyPrefix       = []byte{0x22}
 
 	StatusPresent = []byte{0x01}
 	StatusAbsent = []byte{0x00}
@@ -85,6 +113,17 @@ func GetBlockchainAddressIteratorKey(address sdk.AccAddress) []byte {
 	return append(AddressKeyPrefix, address...)
 }
 
+// Address metadata is stored next to the address under the same AnathaAddress:BlockchainId:AddressIndex key
+func GetAddressMetadataKey(address sdk.AccAddress, blockchainId string, index string) []byte {
+	key := GetAddressKey(address, blockchainId, index)
+
+	return append(AddressMetadataKeyPrefix, key[1:]...)
+}
+
+func GetAddressMetadataIteratorKey(address sdk.AccAddress) []byte {
+	return append(AddressMetadataKeyPrefix, address...)
+}
+
 func SplitBlockchainAddressKey(key []byte) (blockchainAddress BlockchainAddressInfo)  {
 	parts := strings.Split(string(key[22:]), Separator) // prefix + address + first separator
 
@@ -114,6 +153,126 @@ func GetRegisteredBlockchainIdIteratorKey() []byte {
 func SplitRegisteredBlockchainIdKey(key []byte) (string) {
 	return string(key[1:])
 }
+
+func GetAuctionKey(name string) []byte {
+	return append(AuctionKeyPrefix, []byte(name)...)
+}
+
+func AuctionByTimeKey(endTime time.Time) []byte {
+	return append(AuctionQueueKeyPrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+func AuctionQueueKey(name string, endTime time.Time) []byte {
+	return append(AuctionByTimeKey(endTime), []byte(name)...)
+}
+
+func SplitAuctionQueueKey(key []byte) (name string, endTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+func GracePeriodByTimeKey(expiryTime time.Time) []byte {
+	return append(GracePeriodQueueKeyPrefix, sdk.FormatTimeBytes(expiryTime)...)
+}
+
+func GracePeriodQueueKey(name string, expiryTime time.Time) []byte {
+	return append(GracePeriodByTimeKey(expiryTime), []byte(name)...)
+}
+
+func SplitGracePeriodQueueKey(key []byte) (name string, expiryTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+func GetSubnameKey(name string) []byte {
+	return append(SubnameKeyPrefix, []byte(name)...)
+}
+
+func GetSubnameByParentKey(parent string, name string) []byte {
+	key := append(GetSubnameByParentIteratorKey(parent), []byte(name)...)
+	return key
+}
+
+func GetSubnameByParentIteratorKey(parent string) []byte {
+	key := append(SubnameByParentKeyPrefix, []byte(parent)...)
+	key = append(key, []byte(Separator)...)
+	return key
+}
+
+func SplitSubnameByParentKey(key []byte) string {
+	parts := strings.SplitN(string(key[1:]), Separator, 2)
+
+	return parts[1]
+}
+
+func GetSubnameByOwnerKey(owner sdk.AccAddress, name string) []byte {
+	key := append(GetSubnameByOwnerIteratorKey(owner), []byte(name)...)
+	return key
+}
+
+func GetSubnameByOwnerIteratorKey(owner sdk.AccAddress) []byte {
+	key := append(SubnameByOwnerKeyPrefix, owner...)
+	key = append(key, []byte(Separator)...)
+	return key
+}
+
+func SplitSubnameByOwnerKey(key []byte) string {
+	return string(key[1 + sdk.AddrLen + len(Separator):])
+}
+
+func GetPrimaryNameKey(address sdk.AccAddress) []byte {
+	return append(PrimaryNameKeyPrefix, address...)
+}
+
+func GetBlockchainIdValidatorKey(blockchainId string) []byte {
+	return append(BlockchainIdValidatorKeyPrefix, []byte(blockchainId)...)
+}
+
+func GetTextRecordKey(name string, key string) []byte {
+	return append(GetTextRecordIteratorKey(name), []byte(key)...)
+}
+
+func GetTextRecordIteratorKey(name string) []byte {
+	key := append(TextRecordKeyPrefix, []byte(name)...)
+	key = append(key, []byte(Separator)...)
+	return key
+}
+
+func SplitTextRecordKey(key []byte) (name string, recordKey string) {
+	parts := strings.SplitN(string(key[1:]), Separator, 2)
+
+	return parts[0], parts[1]
+}
+
+func GetNameAddressKey(name string, blockchainId string, index string) []byte {
+	key := append(GetNameAddressIteratorKey(name), []byte(blockchainId)...)
+	key = append(key, []byte(Separator)...)
+	key = append(key, []byte(index)...)
+	return key
+}
+
+func GetNameAddressIteratorKey(name string) []byte {
+	key := append(NameAddressKeyPrefix, []byte(name)...)
+	key = append(key, []byte(Separator)...)
+	return key
+}
+
+func SplitNameAddressKey(key []byte) (name string) {
+	parts := strings.SplitN(string(key[1:]), Separator, 2)
+
+	return parts[0]
+}
+
+func GetNameApprovalKey(name string) []byte {
+	return append(NameApprovalKeyPrefix, []byte(name)...)
+}
+
+func GetOperatorApprovalKey(owner sdk.AccAddress, operator sdk.AccAddress) []byte {
+	return append(GetOperatorApprovalIteratorKey(owner), operator...)
+}
+
+func GetOperatorApprovalIteratorKey(owner sdk.AccAddress) []byte {
+	return append(OperatorApprovalKeyPrefix, owner...)
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (name string, endTime time.Time) {
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/DFWallet/anatha/types"
 	sdkerrors "github.com/DFWallet/anatha/types/errors"
 	"github.com/DFWallet/project-anatha/config"
+	"time"
 )
 
 // MsgRegisterName
@@ -288,6 +289,141 @@ func (msg MsgRegisterAddress) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
 }
 
+// MsgRegisterAddressV2 registers a blockchain address together with its optional metadata
+type MsgRegisterAddressV2 struct {
+	Owner             sdk.AccAddress `json:"owner" yaml:"owner"`
+	BlockchainId      string         `json:"blockchain_id" yaml:"blockchain_id"`
+	Index             string         `json:"index" yaml:"index"`
+	BlockchainAddress string         `json:"blockchain_address" yaml:"blockchain_address"`
+	Label             string         `json:"label" yaml:"label"`
+	Memo              string         `json:"memo" yaml:"memo"`
+	Default           bool           `json:"default" yaml:"default"`
+}
+
+func NewMsgRegisterAddressV2(owner sdk.AccAddress, blockchainId string, index string, blockchainAddress string, label string, memo string, isDefault bool) MsgRegisterAddressV2 {
+	return MsgRegisterAddressV2{
+		Owner:             owner,
+		BlockchainId:      blockchainId,
+		Index:             index,
+		BlockchainAddress: blockchainAddress,
+		Label:             label,
+		Memo:              memo,
+		Default:           isDefault,
+	}
+}
+
+func (msg MsgRegisterAddressV2) Route() string { return RouterKey }
+
+func (msg MsgRegisterAddressV2) Type() string { return "register_address_v2" }
+
+func (msg MsgRegisterAddressV2) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	err := validateBlockchainId(msg.BlockchainId)
+	if err != nil {
+		return err
+	}
+
+	err = validateIndex(msg.Index)
+	if err != nil {
+		return err
+	}
+
+	err = validateBlockchainAddress(msg.BlockchainAddress)
+	if err != nil {
+		return err
+	}
+
+	return validateAddressMetadata(msg.Metadata())
+}
+
+func (msg MsgRegisterAddressV2) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRegisterAddressV2) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgRegisterAddressV2) Metadata() AddressMetadata {
+	return NewAddressMetadata(msg.Label, msg.Memo, msg.Default)
+}
+
+// MsgSetAddresses upserts a list of blockchain address records, or replaces all records of the owner when Replace is set
+type MsgSetAddresses struct {
+	Owner     sdk.AccAddress          `json:"owner" yaml:"owner"`
+	Addresses []BlockchainAddressInfo `json:"addresses" yaml:"addresses"`
+	Replace   bool                    `json:"replace" yaml:"replace"`
+}
+
+func NewMsgSetAddresses(owner sdk.AccAddress, addresses []BlockchainAddressInfo, replace bool) MsgSetAddresses {
+	return MsgSetAddresses{
+		Owner:     owner,
+		Addresses: addresses,
+		Replace:   replace,
+	}
+}
+
+func (msg MsgSetAddresses) Route() string { return RouterKey }
+
+func (msg MsgSetAddresses) Type() string { return "set_addresses" }
+
+func (msg MsgSetAddresses) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	if len(msg.Addresses) == 0 && ! msg.Replace {
+		return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "addresses are required")
+	}
+
+	if len(msg.Addresses) > MaxAddressesPerMsg {
+		return sdkerrors.Wrapf(ErrBlockchainAddressNotValid, "at most %d addresses per message", MaxAddressesPerMsg)
+	}
+
+	seen := make(map[string]bool)
+
+	for _, address := range msg.Addresses {
+		err := validateBlockchainId(address.BlockchainId)
+		if err != nil {
+			return err
+		}
+
+		err = validateIndex(address.Index)
+		if err != nil {
+			return err
+		}
+
+		err = validateBlockchainAddress(address.BlockchainAddress)
+		if err != nil {
+			return err
+		}
+
+		err = validateAddressMetadata(address.Metadata())
+		if err != nil {
+			return err
+		}
+
+		key := address.BlockchainId + Separator + address.Index
+		if seen[key] {
+			return sdkerrors.Wrapf(ErrBlockchainAddressNotValid, "duplicate address %s", key)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+func (msg MsgSetAddresses) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetAddresses) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
 // MsgRemoveAddress
 type MsgRemoveAddress struct {
 	Owner sdk.AccAddress 	`json:"owner" yaml:"owner"`
@@ -362,4 +498,575 @@ func (msg MsgRemoveAllAddresses) GetSignBytes() []byte {
 
 func (msg MsgRemoveAllAddresses) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
-}
\ No newline at end of file
+}
+
+// MsgCreateAuction
+type MsgCreateAuction struct {
+	Name         string         `json:"name" yaml:"name"`
+	Owner        sdk.AccAddress `json:"owner" yaml:"owner"`
+	ReservePrice sdk.Coins      `json:"reserve_price" yaml:"reserve_price"`
+	EndTime      string         `json:"end_time" yaml:"end_time"` // has to be string and parsed on server because of broken amino decoding
+}
+
+func NewMsgCreateAuction(name string, owner sdk.AccAddress, reservePrice sdk.Coins, endTime string) MsgCreateAuction {
+	return MsgCreateAuction{
+		Name:         name,
+		Owner:        owner,
+		ReservePrice: reservePrice,
+		EndTime:      endTime,
+	}
+}
+
+func (msg MsgCreateAuction) Route() string { return RouterKey }
+
+func (msg MsgCreateAuction) Type() string { return "create_auction" }
+
+func (msg MsgCreateAuction) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if ! msg.ReservePrice.IsValid() || ! msg.ReservePrice.AmountOf(config.DefaultDenom).IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid reserve price.")
+	}
+	endTime, err := time.Parse("2006-01-02T15:04:05.99999999999Z", msg.EndTime)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidTime, msg.EndTime)
+	}
+	if endTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidTime, msg.EndTime)
+	}
+
+	return nil
+}
+
+func (msg MsgCreateAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreateAuction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgPlaceBid
+type MsgPlaceBid struct {
+	Name   string         `json:"name" yaml:"name"`
+	Bidder sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+func NewMsgPlaceBid(name string, bidder sdk.AccAddress, amount sdk.Coins) MsgPlaceBid {
+	return MsgPlaceBid{
+		Name:   name,
+		Bidder: bidder,
+		Amount: amount,
+	}
+}
+
+func (msg MsgPlaceBid) Route() string { return RouterKey }
+
+func (msg MsgPlaceBid) Type() string { return "place_bid" }
+
+func (msg MsgPlaceBid) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Bidder.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Bidder.String())
+	}
+	if ! msg.Amount.IsValid() || ! msg.Amount.AmountOf(config.DefaultDenom).IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid bid amount.")
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgPlaceBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgCancelAuction
+type MsgCancelAuction struct {
+	Name  string         `json:"name" yaml:"name"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+func NewMsgCancelAuction(name string, owner sdk.AccAddress) MsgCancelAuction {
+	return MsgCancelAuction{
+		Name:  name,
+		Owner: owner,
+	}
+}
+
+func (msg MsgCancelAuction) Route() string { return RouterKey }
+
+func (msg MsgCancelAuction) Type() string { return "cancel_auction" }
+
+func (msg MsgCancelAuction) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	return nil
+}
+
+func (msg MsgCancelAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCancelAuction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+
+// MsgCreateSubname
+type MsgCreateSubname struct {
+	Name         string         `json:"name" yaml:"name"`
+	Owner        sdk.AccAddress `json:"owner" yaml:"owner"`
+	SubnameOwner sdk.AccAddress `json:"subname_owner" yaml:"subname_owner"`
+}
+
+func NewMsgCreateSubname(name string, owner sdk.AccAddress, subnameOwner sdk.AccAddress) MsgCreateSubname {
+	return MsgCreateSubname{
+		Name:         name,
+		Owner:        owner,
+		SubnameOwner: subnameOwner,
+	}
+}
+
+func (msg MsgCreateSubname) Route() string { return RouterKey }
+
+func (msg MsgCreateSubname) Type() string { return "create_subname" }
+
+func (msg MsgCreateSubname) ValidateBasic() error {
+	err := validateSubname(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if msg.SubnameOwner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.SubnameOwner.String())
+	}
+
+	return nil
+}
+
+func (msg MsgCreateSubname) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreateSubname) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRevokeSubname
+type MsgRevokeSubname struct {
+	Name  string         `json:"name" yaml:"name"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+func NewMsgRevokeSubname(name string, owner sdk.AccAddress) MsgRevokeSubname {
+	return MsgRevokeSubname{
+		Name:  name,
+		Owner: owner,
+	}
+}
+
+func (msg MsgRevokeSubname) Route() string { return RouterKey }
+
+func (msg MsgRevokeSubname) Type() string { return "revoke_subname" }
+
+func (msg MsgRevokeSubname) ValidateBasic() error {
+	err := validateSubname(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	return nil
+}
+
+func (msg MsgRevokeSubname) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRevokeSubname) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgSetPrimaryName
+type MsgSetPrimaryName struct {
+	Name  string         `json:"name" yaml:"name"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+func NewMsgSetPrimaryName(name string, owner sdk.AccAddress) MsgSetPrimaryName {
+	return MsgSetPrimaryName{
+		Name:  name,
+		Owner: owner,
+	}
+}
+
+func (msg MsgSetPrimaryName) Route() string { return RouterKey }
+
+func (msg MsgSetPrimaryName) Type() string { return "set_primary_name" }
+
+func (msg MsgSetPrimaryName) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	return nil
+}
+
+func (msg MsgSetPrimaryName) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetPrimaryName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgSetTextRecord
+type MsgSetTextRecord struct {
+	Name  string         `json:"name" yaml:"name"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	Key   string         `json:"key" yaml:"key"`
+	Value string         `json:"value" yaml:"value"`
+}
+
+func NewMsgSetTextRecord(name string, owner sdk.AccAddress, key string, value string) MsgSetTextRecord {
+	return MsgSetTextRecord{
+		Name:  name,
+		Owner: owner,
+		Key:   key,
+		Value: value,
+	}
+}
+
+func (msg MsgSetTextRecord) Route() string { return RouterKey }
+
+func (msg MsgSetTextRecord) Type() string { return "set_text_record" }
+
+func (msg MsgSetTextRecord) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	return validateTextRecord(NewTextRecord(msg.Key, msg.Value))
+}
+
+func (msg MsgSetTextRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetTextRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRemoveTextRecord
+type MsgRemoveTextRecord struct {
+	Name  string         `json:"name" yaml:"name"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	Key   string         `json:"key" yaml:"key"`
+}
+
+func NewMsgRemoveTextRecord(name string, owner sdk.AccAddress, key string) MsgRemoveTextRecord {
+	return MsgRemoveTextRecord{
+		Name:  name,
+		Owner: owner,
+		Key:   key,
+	}
+}
+
+func (msg MsgRemoveTextRecord) Route() string { return RouterKey }
+
+func (msg MsgRemoveTextRecord) Type() string { return "remove_text_record" }
+
+func (msg MsgRemoveTextRecord) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	return validateTextRecordKey(msg.Key)
+}
+
+func (msg MsgRemoveTextRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRemoveTextRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRegisterNameAddress
+type MsgRegisterNameAddress struct {
+	Name              string         `json:"name" yaml:"name"`
+	Owner             sdk.AccAddress `json:"owner" yaml:"owner"`
+	BlockchainId      string         `json:"blockchain_id" yaml:"blockchain_id"`
+	Index             string         `json:"index" yaml:"index"`
+	BlockchainAddress string         `json:"blockchain_address" yaml:"blockchain_address"`
+	Label             string         `json:"label" yaml:"label"`
+	Memo              string         `json:"memo" yaml:"memo"`
+	Default           bool           `json:"default" yaml:"default"`
+}
+
+func NewMsgRegisterNameAddress(name string, owner sdk.AccAddress, blockchainId string, index string, blockchainAddress string, label string, memo string, isDefault bool) MsgRegisterNameAddress {
+	return MsgRegisterNameAddress{
+		Name:              name,
+		Owner:             owner,
+		BlockchainId:      blockchainId,
+		Index:             index,
+		BlockchainAddress: blockchainAddress,
+		Label:             label,
+		Memo:              memo,
+		Default:           isDefault,
+	}
+}
+
+func (msg MsgRegisterNameAddress) Route() string { return RouterKey }
+
+func (msg MsgRegisterNameAddress) Type() string { return "register_name_address" }
+
+func (msg MsgRegisterNameAddress) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	err = validateBlockchainId(msg.BlockchainId)
+	if err != nil {
+		return err
+	}
+
+	err = validateIndex(msg.Index)
+	if err != nil {
+		return err
+	}
+
+	err = validateBlockchainAddress(msg.BlockchainAddress)
+	if err != nil {
+		return err
+	}
+
+	return validateAddressMetadata(msg.Metadata())
+}
+
+func (msg MsgRegisterNameAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRegisterNameAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgRegisterNameAddress) Metadata() AddressMetadata {
+	return NewAddressMetadata(msg.Label, msg.Memo, msg.Default)
+}
+
+// MsgRemoveNameAddress
+type MsgRemoveNameAddress struct {
+	Name         string         `json:"name" yaml:"name"`
+	Owner        sdk.AccAddress `json:"owner" yaml:"owner"`
+	BlockchainId string         `json:"blockchain_id" yaml:"blockchain_id"`
+	Index        string         `json:"index" yaml:"index"`
+}
+
+func NewMsgRemoveNameAddress(name string, owner sdk.AccAddress, blockchainId string, index string) MsgRemoveNameAddress {
+	return MsgRemoveNameAddress{
+		Name:         name,
+		Owner:        owner,
+		BlockchainId: blockchainId,
+		Index:        index,
+	}
+}
+
+func (msg MsgRemoveNameAddress) Route() string { return RouterKey }
+
+func (msg MsgRemoveNameAddress) Type() string { return "remove_name_address" }
+
+func (msg MsgRemoveNameAddress) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	err = validateBlockchainId(msg.BlockchainId)
+	if err != nil {
+		return err
+	}
+
+	return validateIndex(msg.Index)
+}
+
+func (msg MsgRemoveNameAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRemoveNameAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgApprove
+type MsgApprove struct {
+	Name        string         `json:"name" yaml:"name"`
+	Owner       sdk.AccAddress `json:"owner" yaml:"owner"`
+	Operator    sdk.AccAddress `json:"operator" yaml:"operator"`
+	CanTransfer bool           `json:"can_transfer" yaml:"can_transfer"`
+}
+
+func NewMsgApprove(name string, owner sdk.AccAddress, operator sdk.AccAddress, canTransfer bool) MsgApprove {
+	return MsgApprove{
+		Name:        name,
+		Owner:       owner,
+		Operator:    operator,
+		CanTransfer: canTransfer,
+	}
+}
+
+func (msg MsgApprove) Route() string { return RouterKey }
+
+func (msg MsgApprove) Type() string { return "approve" }
+
+func (msg MsgApprove) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if msg.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Operator.String())
+	}
+	if msg.Owner.Equals(msg.Operator) {
+		return ErrInvalidOperator
+	}
+
+	return nil
+}
+
+func (msg MsgApprove) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgApprove) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRevokeApproval
+type MsgRevokeApproval struct {
+	Name  string         `json:"name" yaml:"name"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+func NewMsgRevokeApproval(name string, owner sdk.AccAddress) MsgRevokeApproval {
+	return MsgRevokeApproval{
+		Name:  name,
+		Owner: owner,
+	}
+}
+
+func (msg MsgRevokeApproval) Route() string { return RouterKey }
+
+func (msg MsgRevokeApproval) Type() string { return "revoke_approval" }
+
+func (msg MsgRevokeApproval) ValidateBasic() error {
+	err := validateName(msg.Name)
+	if err != nil {
+		return err
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	return nil
+}
+
+func (msg MsgRevokeApproval) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRevokeApproval) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgSetApprovalForAll
+type MsgSetApprovalForAll struct {
+	Owner       sdk.AccAddress `json:"owner" yaml:"owner"`
+	Operator    sdk.AccAddress `json:"operator" yaml:"operator"`
+	Approved    bool           `json:"approved" yaml:"approved"`
+	CanTransfer bool           `json:"can_transfer" yaml:"can_transfer"`
+}
+
+func NewMsgSetApprovalForAll(owner sdk.AccAddress, operator sdk.AccAddress, approved bool, canTransfer bool) MsgSetApprovalForAll {
+	return MsgSetApprovalForAll{
+		Owner:       owner,
+		Operator:    operator,
+		Approved:    approved,
+		CanTransfer: canTransfer,
+	}
+}
+
+func (msg MsgSetApprovalForAll) Route() string { return RouterKey }
+
+func (msg MsgSetApprovalForAll) Type() string { return "set_approval_for_all" }
+
+func (msg MsgSetApprovalForAll) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if msg.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Operator.String())
+	}
+	if msg.Owner.Equals(msg.Operator) {
+		return ErrInvalidOperator
+	}
+	if ! msg.Approved && msg.CanTransfer {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "transfer can not be granted while revoking an operator")
+	}
+
+	return nil
+}
+
+func (msg MsgSetApprovalForAll) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetApprovalForAll) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
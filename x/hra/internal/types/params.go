@@ -16,6 +16,8 @@ const (
 
 	DefaultNameInfoMaxDuration  = time.Hour * 24 * 365 * 3
 
+	DefaultNameInfoGracePeriod 	= time.Hour * 24 * 30
+
 	NameConstraintBlock = 750
 )
 
@@ -45,6 +47,9 @@ var (
 	KeyAddressRegistrationFee 	= []byte("AddressRegistrationFee")
 
 	KeyNameInfoMaxDuration     = []byte("NameInfoMaxDuration")
+
+	KeyNameInfoGracePeriod 		= []byte("NameInfoGracePeriod")
+	KeyNameInfoRedemptionFee 	= []byte("NameInfoRedemptionFee")
 )
 
 func ParamKeyTable() params.KeyTable {
@@ -58,10 +63,13 @@ type Params struct {
 	NameInfoRenewalFee 		sdk.Coins 		`json:"renewal_fee" yaml:"renewal_fee"`
 	AddressCredits 			sdk.Int			`json:"address_credits" yaml:"address_credits"`
 	AddressRegistrationFee	sdk.Coins		`json:"address_registration_fee" yaml:"address_registration_fee"`
+	NameInfoGracePeriod		time.Duration	`json:"nameinfo_grace_period" yaml:"nameinfo_grace_period"`
+	NameInfoRedemptionFee	sdk.Coins		`json:"redemption_fee" yaml:"redemption_fee"`
 }
 
 func NewParams(nameInfoDuration time.Duration, nameInfoMaxDuration time.Duration, nameInfoRegistrationFee sdk.Coins,
-	nameInfoRenewalFee sdk.Coins, addressCredits sdk.Int, addressRegistrationFee sdk.Coins) Params {
+	nameInfoRenewalFee sdk.Coins, addressCredits sdk.Int, addressRegistrationFee sdk.Coins, nameInfoGracePeriod time.Duration,
+	nameInfoRedemptionFee sdk.Coins) Params {
 	return Params{
 		NameInfoDuration: nameInfoDuration,
 		NameInfoMaxDuration: nameInfoMaxDuration,
@@ -69,6 +77,8 @@ func NewParams(nameInfoDuration time.Duration, nameInfoMaxDuration time.Duration
 		NameInfoRenewalFee: nameInfoRenewalFee,
 		AddressCredits: addressCredits,
 		AddressRegistrationFee: addressRegistrationFee,
+		NameInfoGracePeriod: nameInfoGracePeriod,
+		NameInfoRedemptionFee: nameInfoRedemptionFee,
 	}
 }
 
@@ -80,13 +90,17 @@ func (p Params) String() string {
   NameInfoRegistrationFee: %s
   NameInfoRenewalFee: %s
   AddressCredits: %s
-  AddressRegistrationFee: %s`,
+  AddressRegistrationFee: %s
+  NameInfoGracePeriod: %s
+  NameInfoRedemptionFee: %s`,
 		p.NameInfoDuration,
 		p.NameInfoMaxDuration,
 		p.NameInfoRegistrationFee,
 		p.NameInfoRenewalFee,
 		p.AddressCredits,
 		p.AddressRegistrationFee,
+		p.NameInfoGracePeriod,
+		p.NameInfoRedemptionFee,
 	)
 }
 
@@ -99,6 +113,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyNameInfoRenewalFee, &p.NameInfoRenewalFee, validateFee),
 		params.NewParamSetPair(KeyAddressCredits, &p.AddressCredits, validateAddressCredits),
 		params.NewParamSetPair(KeyAddressRegistrationFee, &p.AddressRegistrationFee, validateFee),
+		params.NewParamSetPair(KeyNameInfoGracePeriod, &p.NameInfoGracePeriod, validateNameInfoGracePeriod),
+		params.NewParamSetPair(KeyNameInfoRedemptionFee, &p.NameInfoRedemptionFee, validateFee),
 	}
 }
 
@@ -117,6 +133,8 @@ func DefaultParams() Params {
 		defaultNameInfoCoinsFee,
 		DefaultAddressCredits,
 		defaultAddressRegistrationCoinsFee,
+		DefaultNameInfoGracePeriod,
+		defaultNameInfoCoinsFee,
 	)
 }
 
@@ -145,6 +163,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateNameInfoGracePeriod(p.NameInfoGracePeriod); err != nil {
+		return err
+	}
+
+	if err := validateFee(p.NameInfoRedemptionFee); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -161,6 +187,19 @@ func validateNameInfoDuration(i interface{}) error {
 	return nil
 }
 
+func validateNameInfoGracePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("name info grace period must not be negative: %d", v)
+	}
+
+	return nil
+}
+
 func validateFee(i interface{}) error {
 	v, ok := i.(sdk.Coins)
 	if !ok {
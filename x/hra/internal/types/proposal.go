@@ -23,10 +23,11 @@ type RegisterBlockchainIdProposal struct {
 	Title       	string `json:"title" yaml:"title"`
 	Description 	string `json:"description" yaml:"description"`
 	BlockchainId 	string `json:"blockchain_id" yaml:"blockchain_id"`
+	AddressValidator string `json:"address_validator" yaml:"address_validator"` // optional, the current validator is kept when empty
 }
 
-func NewRegisterBlockchainIdProposal(title string, description string, blockchainId string) gov.Content {
-	return RegisterBlockchainIdProposal{title, description, blockchainId}
+func NewRegisterBlockchainIdProposal(title string, description string, blockchainId string, addressValidator string) gov.Content {
+	return RegisterBlockchainIdProposal{title, description, blockchainId, addressValidator}
 }
 
 // Implements Proposal Interface
@@ -41,6 +42,11 @@ func (p RegisterBlockchainIdProposal) ValidateBasic() error {
 	if err := validateBlockchainId(p.BlockchainId); err != nil {
 		return err
 	}
+	if p.AddressValidator != "" {
+		if err := validateAddressValidatorName(p.AddressValidator); err != nil {
+			return err
+		}
+	}
 	return gov.ValidateAbstract(p)
 }
 
@@ -49,7 +55,8 @@ func (p RegisterBlockchainIdProposal) String() string {
   Title:       %s
   Description: %s
   Blockchain Id: %s
-`, p.Title, p.Description, p.BlockchainId)
+  Address Validator: %s
+`, p.Title, p.Description, p.BlockchainId, p.AddressValidator)
 }
 
 // RemoveBlockchainIdProposal
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	sdk "github.com/DFWallet/anatha/types"
 	"strings"
+	"time"
 )
 
 
@@ -11,12 +12,14 @@ type QueryResNameInfo struct {
 	NameInfo 	NameInfo 					`json:"name_info" yaml:"name_info"`
 	Credits 	sdk.Int 					`json:"credits" yaml:"credits"`
 	Addresses 	[]BlockchainAddressInfo 	`json:"addresses" yaml:"addresses"`
+	TextRecords []TextRecord				`json:"text_records" yaml:"text_records"`
 }
 
 func (n QueryResNameInfo) String() string {
 	return fmt.Sprintf(`%s
 %s
-%s`, n.NameInfo, n.Credits, n.Addresses)
+%s
+%s`, n.NameInfo, n.Credits, n.Addresses, n.TextRecords)
 }
 
 type QueryResNameInfos []NameInfo
@@ -60,3 +63,76 @@ type QueryResBlockchainAddresses []BlockchainAddressInfo
 func (n QueryResBlockchainAddresses) String() string {
 	return fmt.Sprintf(`%s`, n)
 }
+
+
+type QueryResAuctions []Auction
+
+func (n QueryResAuctions) String() string {
+	var auctions []string
+
+	for _, auction := range n {
+		auctions = append(auctions, auction.String())
+	}
+
+	return strings.Join(auctions, "\n")
+}
+
+
+type QueryResNameInfoGracePeriods []NameInfoGracePeriod
+
+func (n QueryResNameInfoGracePeriods) String() string {
+	var nameInfos []string
+
+	for _, nameInfo := range n {
+		nameInfos = append(nameInfos, nameInfo.String())
+	}
+
+	return strings.Join(nameInfos, "\n")
+}
+
+
+type QueryResSubname struct {
+	Subname    Subname   `json:"subname" yaml:"subname"`
+	ExpiryTime time.Time `json:"expiry_time" yaml:"expiry_time"`
+}
+
+func (n QueryResSubname) String() string {
+	return fmt.Sprintf(`%s
+Expiry time: %s`, n.Subname, n.ExpiryTime)
+}
+
+type QueryResSubnames []Subname
+
+func (n QueryResSubnames) String() string {
+	var subnames []string
+
+	for _, subname := range n {
+		subnames = append(subnames, subname.String())
+	}
+
+	return strings.Join(subnames, "\n")
+}
+
+type QueryResBlockchainIdValidators []BlockchainIdValidator
+
+func (n QueryResBlockchainIdValidators) String() string {
+	var validators []string
+
+	for _, validator := range n {
+		validators = append(validators, validator.String())
+	}
+
+	return strings.Join(validators, "\n")
+}
+
+type QueryResApprovals []Approval
+
+func (n QueryResApprovals) String() string {
+	var approvals []string
+
+	for _, approval := range n {
+		approvals = append(approvals, approval.String())
+	}
+
+	return strings.Join(approvals, "\n")
+}
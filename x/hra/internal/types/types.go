@@ -25,6 +25,11 @@ func NewNameInfo(name string) NameInfo {
 	}
 }
 
+// IsExpired returns true if the name expired at the given time
+func (h NameInfo) IsExpired(blockTime time.Time) bool {
+	return ! blockTime.Before(h.ExpiryTime)
+}
+
 func (h NameInfo) String() string {
  	return fmt.Sprintf(`Name: %s
 Owner: %s
@@ -55,6 +60,9 @@ type BlockchainAddressInfo struct {
 	BlockchainId string		`json:"blockchain_id" yaml:"blockchain_id"`
 	Index string			`json:"index" yaml:"index"`
 	BlockchainAddress string`json:"blockchain_address" yaml:"blockchain_address"`
+	Label string			`json:"label,omitempty" yaml:"label,omitempty"`
+	Memo string				`json:"memo,omitempty" yaml:"memo,omitempty"`
+	Default bool			`json:"default,omitempty" yaml:"default,omitempty"`
 }
 
 func NewBlockchainAddressInfo(blockchainId string, index string, blockchainAddress string) BlockchainAddressInfo {
@@ -68,7 +76,39 @@ func NewBlockchainAddressInfo(blockchainId string, index string, blockchainAddre
 func (a BlockchainAddressInfo) String() string {
 	return fmt.Sprintf(`BlockchainId: %s
 Index: %s
-BlockchainAddress: %s`, a.BlockchainId, a.Index, a.BlockchainAddress)
+BlockchainAddress: %s
+Label: %s
+Memo: %s
+Default: %t`, a.BlockchainId, a.Index, a.BlockchainAddress, a.Label, a.Memo, a.Default)
+}
+
+func (a BlockchainAddressInfo) Metadata() AddressMetadata {
+	return NewAddressMetadata(a.Label, a.Memo, a.Default)
+}
+
+func (a *BlockchainAddressInfo) SetMetadata(metadata AddressMetadata) {
+	a.Label = metadata.Label
+	a.Memo = metadata.Memo
+	a.Default = metadata.Default
+}
+
+// AddressMetadata is the optional metadata of a blockchain address record
+type AddressMetadata struct {
+	Label   string `json:"label" yaml:"label"`     // human readable label, e.g. exchange deposit
+	Memo    string `json:"memo" yaml:"memo"`       // destination tag or memo required by the receiving address
+	Default bool   `json:"default" yaml:"default"` // index resolvers should prefer for the blockchain id
+}
+
+func NewAddressMetadata(label string, memo string, isDefault bool) AddressMetadata {
+	return AddressMetadata{
+		Label:   label,
+		Memo:    memo,
+		Default: isDefault,
+	}
+}
+
+func (m AddressMetadata) IsEmpty() bool {
+	return m.Label == "" && m.Memo == "" && ! m.Default
 }
 
 
@@ -84,4 +124,176 @@ func NewAddressCreditsInfo(address sdk.AccAddress, credits sdk.Int) AddressCredi
 func (a AddressCreditsInfo) String() string {
 	return fmt.Sprintf(`Address: %s
 Credits: %s`, a.Address, a.Credits)
-}
\ No newline at end of file
+}
+type Auction struct {
+	Name          string         `json:"name" yaml:"name"`
+	Owner         sdk.AccAddress `json:"owner" yaml:"owner"`
+	ReservePrice  sdk.Coins      `json:"reserve_price" yaml:"reserve_price"`
+	EndTime       time.Time      `json:"end_time" yaml:"end_time"`
+	HighestBidder sdk.AccAddress `json:"highest_bidder" yaml:"highest_bidder"`
+	HighestBid    sdk.Coins      `json:"highest_bid" yaml:"highest_bid"`
+}
+
+func NewAuction(name string, owner sdk.AccAddress, reservePrice sdk.Coins, endTime time.Time) Auction {
+	return Auction{
+		Name:          name,
+		Owner:         owner,
+		ReservePrice:  reservePrice,
+		EndTime:       endTime,
+		HighestBidder: nil,
+		HighestBid:    sdk.NewCoins(),
+	}
+}
+
+// HasBids returns true if at least one bid has been placed on the auction
+func (a Auction) HasBids() bool {
+	return ! a.HighestBidder.Empty()
+}
+
+func (a Auction) String() string {
+	return fmt.Sprintf(`Name: %s
+Owner: %s
+Reserve price: %s
+End time: %s
+Highest bidder: %s
+Highest bid: %s`, a.Name, a.Owner, a.ReservePrice, a.EndTime, a.HighestBidder, a.HighestBid)
+}
+
+
+type NameInfoGracePeriod struct {
+	NameInfo       NameInfo  `json:"name_info" yaml:"name_info"`
+	GracePeriodEnd time.Time `json:"grace_period_end" yaml:"grace_period_end"`
+}
+
+func NewNameInfoGracePeriod(nameInfo NameInfo, gracePeriodEnd time.Time) NameInfoGracePeriod {
+	return NameInfoGracePeriod{
+		NameInfo:       nameInfo,
+		GracePeriodEnd: gracePeriodEnd,
+	}
+}
+
+func (g NameInfoGracePeriod) String() string {
+	return fmt.Sprintf(`%s
+Grace period end: %s`, g.NameInfo, g.GracePeriodEnd)
+}
+
+
+type Subname struct {
+	Name         string         `json:"name" yaml:"name"`
+	Parent       string         `json:"parent" yaml:"parent"`
+	Owner        sdk.AccAddress `json:"owner" yaml:"owner"`
+	CreationTime time.Time      `json:"creation_time" yaml:"creation_time"`
+}
+
+func NewSubname(name string, parent string, owner sdk.AccAddress, creationTime time.Time) Subname {
+	return Subname{
+		Name:         name,
+		Parent:       parent,
+		Owner:        owner,
+		CreationTime: creationTime,
+	}
+}
+
+func (s Subname) String() string {
+	return fmt.Sprintf(`Name: %s
+Parent: %s
+Owner: %s
+Creation time: %s`, s.Name, s.Parent, s.Owner, s.CreationTime)
+}
+
+type PrimaryNameInfo struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Name    string         `json:"name" yaml:"name"`
+}
+
+func NewPrimaryNameInfo(address sdk.AccAddress, name string) PrimaryNameInfo {
+	return PrimaryNameInfo{Address: address, Name: name}
+}
+
+func (p PrimaryNameInfo) String() string {
+	return fmt.Sprintf(`Address: %s
+Name: %s`, p.Address, p.Name)
+}
+
+type BlockchainIdValidator struct {
+	BlockchainId     string `json:"blockchain_id" yaml:"blockchain_id"`
+	AddressValidator string `json:"address_validator" yaml:"address_validator"`
+}
+
+func NewBlockchainIdValidator(blockchainId string, addressValidator string) BlockchainIdValidator {
+	return BlockchainIdValidator{BlockchainId: blockchainId, AddressValidator: addressValidator}
+}
+
+func (b BlockchainIdValidator) String() string {
+	return fmt.Sprintf(`Blockchain Id: %s
+Address validator: %s`, b.BlockchainId, b.AddressValidator)
+}
+
+type TextRecord struct {
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+func NewTextRecord(key string, value string) TextRecord {
+	return TextRecord{Key: key, Value: value}
+}
+
+func (r TextRecord) String() string {
+	return fmt.Sprintf("%s: %s", r.Key, r.Value)
+}
+
+type NameBlockchainAddressInfo struct {
+	Name                  string                `json:"name" yaml:"name"`
+	BlockchainAddressInfo BlockchainAddressInfo `json:"blockchain_address_info" yaml:"blockchain_address_info"`
+}
+
+func NewNameBlockchainAddressInfo(name string, blockchainAddress BlockchainAddressInfo) NameBlockchainAddressInfo {
+	return NameBlockchainAddressInfo{Name: name, BlockchainAddressInfo: blockchainAddress}
+}
+
+func (r NameBlockchainAddressInfo) String() string {
+	return fmt.Sprintf(`Name: %s
+Blockchain address: %s`, r.Name, r.BlockchainAddressInfo)
+}
+
+type NameTextRecord struct {
+	Name       string     `json:"name" yaml:"name"`
+	TextRecord TextRecord `json:"text_record" yaml:"text_record"`
+}
+
+func NewNameTextRecord(name string, textRecord TextRecord) NameTextRecord {
+	return NameTextRecord{Name: name, TextRecord: textRecord}
+}
+
+func (r NameTextRecord) String() string {
+	return fmt.Sprintf(`Name: %s
+%s`, r.Name, r.TextRecord)
+}
+
+// Approval allows an operator to manage the records and renewals of a name, or of all names of the owner when Name is empty
+type Approval struct {
+	Name        string         `json:"name,omitempty" yaml:"name,omitempty"`
+	Owner       sdk.AccAddress `json:"owner" yaml:"owner"`
+	Operator    sdk.AccAddress `json:"operator" yaml:"operator"`
+	CanTransfer bool           `json:"can_transfer" yaml:"can_transfer"`
+}
+
+func NewApproval(name string, owner sdk.AccAddress, operator sdk.AccAddress, canTransfer bool) Approval {
+	return Approval{
+		Name:        name,
+		Owner:       owner,
+		Operator:    operator,
+		CanTransfer: canTransfer,
+	}
+}
+
+func (a Approval) IsForAll() bool {
+	return a.Name == ""
+}
+
+func (a Approval) String() string {
+	return fmt.Sprintf(`Name: %s
+Owner: %s
+Operator: %s
+Can transfer: %t`, a.Name, a.Owner, a.Operator, a.CanTransfer)
+}
@@ -4,16 +4,27 @@ import (
 	sdkerrors "github.com/DFWallet/anatha/types/errors"
 	"regexp"
 	"strconv"
+	"strings"
 )
 
 const (
 	validChar = `[a-z0-9\.,\+\-_]`
 	blockchainAddressMaxLen = 128
+	addressLabelMaxLen = 64
+	addressMemoMaxLen = 256
+	textRecordValueMaxLen = 512
+
+	// MaxTextRecords is the maximum number of text records per name
+	MaxTextRecords = 16
+
+	// MaxAddressesPerMsg is the maximum number of address records in a single MsgSetAddresses
+	MaxAddressesPerMsg = 100
 )
 
 var (
 	validBlockchainId = regexp.MustCompile(`^[a-zA-Z0-9_.-]{3,32}$`).MatchString
 	validName = regexp.MustCompile(`^` + validChar + `{3,64}$`).MatchString
+	validTextRecordKey = regexp.MustCompile(`^[a-z0-9_.\-]{1,32}$`).MatchString
 )
 
 func validateName(name string) error {
@@ -24,6 +35,29 @@ func validateName(name string) error {
 	return nil
 }
 
+func validateSubname(name string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+
+	label, parent, ok := SplitSubname(name)
+	if ! ok || label == "" || ! validName(parent) {
+		return sdkerrors.Wrap(ErrNameNotValid, "subname has to be in the label.parent format")
+	}
+
+	return nil
+}
+
+// SplitSubname splits a subname into its label and the parent name, e.g. alice.team into alice and team
+func SplitSubname(name string) (label string, parent string, ok bool) {
+	index := strings.Index(name, ".")
+	if index < 0 {
+		return "", "", false
+	}
+
+	return name[:index], name[index+1:], true
+}
+
 func validateBlockchainId(blockchainId string) error {
 	if ! validBlockchainId(blockchainId) {
 		return ErrBlockchainIdNotValid
@@ -40,6 +74,39 @@ func validateIndex(index string) error {
 	return nil
 }
 
+func validateAddressMetadata(metadata AddressMetadata) error {
+	if len(metadata.Label) > addressLabelMaxLen {
+		return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "label too long")
+	}
+	if len(metadata.Memo) > addressMemoMaxLen {
+		return sdkerrors.Wrap(ErrBlockchainAddressNotValid, "memo too long")
+	}
+
+	return nil
+}
+
+func validateTextRecordKey(key string) error {
+	if ! validTextRecordKey(key) {
+		return ErrTextRecordNotValid
+	}
+
+	return nil
+}
+
+func validateTextRecord(record TextRecord) error {
+	if err := validateTextRecordKey(record.Key); err != nil {
+		return err
+	}
+	if len(record.Value) == 0 {
+		return sdkerrors.Wrap(ErrTextRecordNotValid, "value is required")
+	}
+	if len(record.Value) > textRecordValueMaxLen {
+		return sdkerrors.Wrap(ErrTextRecordNotValid, "value too long")
+	}
+
+	return nil
+}
+
 func validateBlockchainAddress(blockchainAddress string) error {
 	length := len(blockchainAddress)
 
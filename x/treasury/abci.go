@@ -10,16 +10,25 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {}
 
 func EndBlocker(ctx sdk.Context, k Keeper) {
 	 k.IterateScheduledDisbursementQueue(ctx, ctx.BlockTime(), func(disbursement types.Disbursement) (stop bool) {
-
-	 	_, fromBuyBack, fromTreasury := k.CalculatePinAmountExtended(ctx, disbursement.Amount)
-
-		err := k.DisburseFunds(ctx, disbursement.Operator, disbursement.Recipient, disbursement.Amount, fromBuyBack, fromTreasury)
+		err := k.ExecuteDisbursement(ctx, disbursement.Operator, disbursement)
 		if err != nil {
-			k.Logger(ctx).Info(err.Error())
+			k.HandleFailedDisbursement(ctx, disbursement, err)
 		}
 
 		k.RemoveFromDisbursementQueue(ctx,disbursement.Recipient, disbursement.ScheduledFor)
 
 	 	return false
 	 })
+
+	// retries are collected first since a failed retry moves the entry within the queue
+	for _, failed := range k.GetDueFailedDisbursements(ctx, ctx.BlockTime()) {
+		err := k.RetryFailedDisbursement(ctx, failed, failed.Disbursement.Operator)
+		if err != nil {
+			k.HandleFailedDisbursement(ctx, failed.Disbursement, err)
+		}
+	}
+
+	k.MatchLimitOrders(ctx)
+
+	k.TrackSnapshots(ctx)
 }
@@ -16,25 +16,40 @@ const (
 	DistributionProfitsModuleName = types.DistributionProfitsModuleName
 	TreasuryEscrowModuleName = types.TreasuryEscrowModuleName
 	SwapEscrowModuleName	= types.SwapEscrowModuleName
+	OrderBookModuleName		= types.OrderBookModuleName
+	OrderSideBuy			= types.OrderSideBuy
+	OrderSideSell			= types.OrderSideSell
 )
 
 var (
 	// functions aliases
 	NewKeeper                          = keeper.NewKeeper
 	NewQuerier                         = keeper.NewQuerier
+	RegisterInvariants                 = keeper.RegisterInvariants
+	AllInvariants                      = keeper.AllInvariants
 	RegisterCodec                      = types.RegisterCodec
 	NewGenesisState                    = types.NewGenesisState
 	DefaultGenesisState                = types.DefaultGenesisState
 	ValidateGenesis                    = types.ValidateGenesis
 	NewMsgAddOperator 					= types.NewMsgAddOperator
 	NewMsgRemoveOperator 				= types.NewMsgRemoveOperator
+	NewMsgSetOperatorPolicy				= types.NewMsgSetOperatorPolicy
+	NewOperatorPolicy					= types.NewOperatorPolicy
 	NewMsgDisburse 						= types.NewMsgDisburse
 	NewMsgDisburseToEscrow              = types.NewMsgDisburseToEscrow
 	NewMsgDisburseFromEscrow            = types.NewMsgDisburseFromEscrow
 	NewMsgRevertFromEscrow              = types.NewMsgRevertFromEscrow
 	NewMsgCancelDisbursement			= types.NewMsgCancelDisbursement
+	NewMsgCancelDisbursementByReference	= types.NewMsgCancelDisbursementByReference
+	NewMsgCancelOperatorDisbursements	= types.NewMsgCancelOperatorDisbursements
+	NewMsgRetryDisbursement				= types.NewMsgRetryDisbursement
+	NewMsgAbandonDisbursement			= types.NewMsgAbandonDisbursement
+	NewMsgApproveDisbursement			= types.NewMsgApproveDisbursement
+	NewMsgVetoDisbursement				= types.NewMsgVetoDisbursement
 	NewMsgCreateSellOrder				= types.NewMsgCreateSellOrder
 	NewMsgCreateBuyOrder				= types.NewMsgCreateBuyOrder
+	NewMsgCreateLimitOrder				= types.NewMsgCreateLimitOrder
+	NewMsgCancelLimitOrder				= types.NewMsgCancelLimitOrder
 	NewMsgSwap							= types.NewMsgSwap
 
 	NewAddBuyBackLiquidityProposal = types.NewAddBuyBackLiquidityProposal
@@ -43,6 +58,7 @@ var (
 	NewTransferFromDistributionProfitsToBuyBackLiquidityProposal = types.NewTransferFromDistributionProfitsToBuyBackLiquidityProposal
 	NewTransferFromTreasuryToSwapEscrowProposal = types.NewTransferFromTreasuryToSwapEscrowProposal
 	NewTransferFromSwapEscrowToBuyBackProposal = types.NewTransferFromSwapEscrowToBuyBackProposal
+	NewSetPriceCurveProposal = types.NewSetPriceCurveProposal
 
 	// variable aliases
 	ModuleCdc     = types.ModuleCdc
@@ -62,12 +78,29 @@ type (
 
 	MsgAddOperator 					= types.MsgAddOperator
 	MsgRemoveOperator 				= types.MsgRemoveOperator
+	MsgSetOperatorPolicy			= types.MsgSetOperatorPolicy
+	OperatorPolicy					= types.OperatorPolicy
 	MsgDisburse 					= types.MsgDisburse
 	MsgDisburseToEscrow             = types.MsgDisburseToEscrow
 	MsgDisburseFromEscrow           = types.MsgDisburseFromEscrow
 	MsgRevertFromEscrow             = types.MsgRevertFromEscrow
 	MsgCancelDisbursement			= types.MsgCancelDisbursement
+	MsgCancelDisbursementByReference	= types.MsgCancelDisbursementByReference
+	MsgCancelOperatorDisbursements	= types.MsgCancelOperatorDisbursements
+	MsgRetryDisbursement			= types.MsgRetryDisbursement
+	MsgAbandonDisbursement			= types.MsgAbandonDisbursement
+	MsgApproveDisbursement			= types.MsgApproveDisbursement
+	MsgVetoDisbursement				= types.MsgVetoDisbursement
 	MsgCreateSellOrder				= types.MsgCreateSellOrder
 	MsgCreateBuyOrder				= types.MsgCreateBuyOrder
+	MsgCreateLimitOrder				= types.MsgCreateLimitOrder
+	MsgCancelLimitOrder				= types.MsgCancelLimitOrder
+	LimitOrder						= types.LimitOrder
+	Quote							= types.Quote
+	QuoteStage						= types.QuoteStage
+	SetPriceCurveProposal			= types.SetPriceCurveProposal
+	ScheduledPriceCurve				= types.ScheduledPriceCurve
+	PriceCurveParams				= types.PriceCurveParams
+	TreasurySnapshot				= types.TreasurySnapshot
 	MsgSwap							= types.MsgSwap
 )
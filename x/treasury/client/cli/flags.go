@@ -0,0 +1,14 @@
+package cli
+
+const (
+	FlagMaxPerTransaction = "max-per-tx"
+	FlagDailyQuota        = "daily-quota"
+	FlagWeeklyQuota       = "weekly-quota"
+	FlagAllowedRecipients = "allowed-recipients"
+	FlagMinReceived       = "min-received"
+	FlagFromHeight        = "from-height"
+	FlagToHeight          = "to-height"
+	FlagFromTime          = "from-time"
+	FlagToTime            = "to-time"
+	FlagLimit             = "limit"
+)
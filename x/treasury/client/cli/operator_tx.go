@@ -8,10 +8,13 @@ import (
 	"github.com/DFWallet/anatha/x/auth/client/utils"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"strings"
 
 	"github.com/DFWallet/anatha/client"
 	"github.com/DFWallet/anatha/client/flags"
 	"github.com/DFWallet/anatha/codec"
+	denom "github.com/DFWallet/project-anatha/utils"
 	"github.com/DFWallet/project-anatha/x/treasury/internal/types"
 
 
@@ -30,6 +33,7 @@ func GetCmdOperator(cdc *codec.Codec) *cobra.Command {
 	operatorTxCmd.AddCommand(flags.PostCommands(
 		GetCmdAddOpeator(cdc),
 		GetCmdRemoveOpeator(cdc),
+		GetCmdSetOperatorPolicy(cdc),
 	)...)
 
 	return operatorTxCmd
@@ -88,3 +92,62 @@ func GetCmdRemoveOpeator(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetCmdSetOperatorPolicy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-policy [address]",
+		Short: "Set the spending limits of a Treasury Distribution Operator, omitted limits are not enforced",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			operator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			limits := make([]sdk.Coins, 3)
+			for i, flag := range []string{FlagMaxPerTransaction, FlagDailyQuota, FlagWeeklyQuota} {
+				limits[i], err = denom.ParseAndConvertCoins(viper.GetString(flag))
+				if err != nil {
+					return err
+				}
+			}
+
+			var recipients []sdk.AccAddress
+			for _, value := range strings.Split(viper.GetString(FlagAllowedRecipients), ",") {
+				value = strings.TrimSpace(value)
+				if value == "" {
+					continue
+				}
+
+				recipient, err := sdk.AccAddressFromBech32(value)
+				if err != nil {
+					return err
+				}
+
+				recipients = append(recipients, recipient)
+			}
+
+			policy := types.NewOperatorPolicy(operator, limits[0], limits[1], limits[2], recipients)
+
+			msg := types.NewMsgSetOperatorPolicy(cliCtx.GetFromAddress(), policy)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagMaxPerTransaction, "", "Maximum amount per transaction, e.g. 1000din,500pin")
+	cmd.Flags().String(FlagDailyQuota, "", "Maximum amount within 24 hours")
+	cmd.Flags().String(FlagWeeklyQuota, "", "Maximum amount within 7 days")
+	cmd.Flags().String(FlagAllowedRecipients, "", "Comma separated list of recipients the operator may pay")
+
+	return cmd
+}
@@ -9,6 +9,8 @@ import (
 	denom "github.com/DFWallet/project-anatha/utils"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"strconv"
 
 	"github.com/DFWallet/anatha/client"
 	"github.com/DFWallet/anatha/client/flags"
@@ -31,13 +33,16 @@ func GetCmdOrder(cdc *codec.Codec) *cobra.Command {
 	orderTxCmd.AddCommand(flags.PostCommands(
 		GetCmdAddSellOrder(cdc),
 		GetCmdAddBuyOrder(cdc),
+		GetCmdAddLimitBuyOrder(cdc),
+		GetCmdAddLimitSellOrder(cdc),
+		GetCmdCancelLimitOrder(cdc),
 	)...)
 
 	return orderTxCmd
 }
 
 func GetCmdAddSellOrder(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "sell [anatha-amount]",
 		Short: "Create a sell order",
 		Args:  cobra.ExactArgs(1),
@@ -52,7 +57,12 @@ func GetCmdAddSellOrder(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgCreateSellOrder(cliCtx.GetFromAddress(), amount)
+			minReceived, err := denom.ParseAndConvertCoins(viper.GetString(FlagMinReceived))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSellOrder(cliCtx.GetFromAddress(), amount, minReceived)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -61,10 +71,14 @@ func GetCmdAddSellOrder(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().String(FlagMinReceived, "", "Minimum amount to receive, the order fails if the price moved below it")
+
+	return cmd
 }
 
 func GetCmdAddBuyOrder(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "buy [ast-amount]",
 		Short: "Create a buy order",
 		Args:  cobra.ExactArgs(1),
@@ -79,7 +93,90 @@ func GetCmdAddBuyOrder(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgCreateBuyOrder(cliCtx.GetFromAddress(), amount)
+			minReceived, err := denom.ParseAndConvertCoins(viper.GetString(FlagMinReceived))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateBuyOrder(cliCtx.GetFromAddress(), amount, minReceived)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagMinReceived, "", "Minimum amount to receive, the order fails if the price moved below it")
+
+	return cmd
+}
+
+func GetCmdAddLimitBuyOrder(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "limit-buy [ast-amount] [price]",
+		Short: "Create a buy order which is filled once the stage price drops to the given price",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return createLimitOrder(cmd, cdc, types.OrderSideBuy, args)
+		},
+	}
+}
+
+func GetCmdAddLimitSellOrder(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "limit-sell [anatha-amount] [price]",
+		Short: "Create a sell order which is filled once the buyback price rises to the given price",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return createLimitOrder(cmd, cdc, types.OrderSideSell, args)
+		},
+	}
+}
+
+func createLimitOrder(cmd *cobra.Command, cdc *codec.Codec, side string, args []string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+	amount, err := denom.ParseAndConvertCoins(args[0])
+	if err != nil {
+		return err
+	}
+
+	price, ok := sdk.NewIntFromString(args[1])
+	if ! ok {
+		return fmt.Errorf("invalid price: %s", args[1])
+	}
+
+	msg := types.NewMsgCreateLimitOrder(cliCtx.GetFromAddress(), side, amount, price)
+	err = msg.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
+
+func GetCmdCancelLimitOrder(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel [id]",
+		Short: "Cancel a resting limit order and refund its funds",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelLimitOrder(cliCtx.GetFromAddress(), id)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
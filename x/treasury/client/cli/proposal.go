@@ -216,3 +216,50 @@ func GetCmdSubmitTransferFromSwapEscrowToBuyBackProposal(cdc *codec.Codec) *cobr
 
 	return cmd
 }
+
+func GetCmdSubmitSetPriceCurveProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-price-curve [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Set the price curve from a given stage",
+		Long: `Set the price curve from a given stage. The curve type is one of linear, exponential or piecewise:
+
+{
+  "title": "Exponential pricing",
+  "description": "Grow the price by 1% per stage from stage 100",
+  "from_stage": "100",
+  "curve": {
+    "type": "exponential",
+    "base": "101",
+    "rate": "0.010000000000000000"
+  }
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := treasuryutils.ParseSetPriceCurveProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewSetPriceCurveProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.FromStage,
+				proposal.Curve,
+			)
+
+			msg := governance.NewMsgSubmitProposal(content, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
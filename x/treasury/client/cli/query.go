@@ -6,6 +6,8 @@ import (
 	sdk "github.com/DFWallet/anatha/types"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"time"
 
 	"github.com/DFWallet/anatha/client"
 	"github.com/DFWallet/anatha/client/flags"
@@ -30,8 +32,16 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdQueryParams(queryRoute, cdc),
 			GetCmdQueryTreasury(queryRoute, cdc),
 			GetCmdOperators(queryRoute, cdc),
+			GetCmdQueryOperator(queryRoute, cdc),
 			GetCmdDisbursements(queryRoute, cdc),
+			GetCmdFailedDisbursements(queryRoute, cdc),
+			GetCmdDisbursementRecord(queryRoute, cdc),
+			GetCmdPendingDisbursements(queryRoute, cdc),
+			GetCmdLimitOrders(queryRoute, cdc),
 			GetCmdQueryPrice(queryRoute, cdc),
+			GetCmdQueryQuote(queryRoute, cdc),
+			GetCmdQueryPriceCurve(queryRoute, cdc),
+			GetCmdQuerySnapshots(queryRoute, cdc),
 			GetCmdQueryDisbursementEscrow(queryRoute, cdc),
 		)...,
 	)
@@ -108,6 +118,48 @@ func GetCmdOperators(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdQueryOperator(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "operator [address]",
+		Short: "Query the spending policy and usage of a Treasury Distribution Operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/operator/%s", queryRoute, args[0]), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve operator - %s \n", args[0])
+				return nil
+			}
+
+			var out types.QueryResOperator
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdLimitOrders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "limit-orders [address]",
+		Short: "Query the resting limit orders of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/limit-orders/%s", queryRoute, args[0]), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve limit orders - %s \n", args[0])
+				return nil
+			}
+
+			var out []types.LimitOrder
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 func GetCmdDisbursements(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "disbursements",
@@ -129,6 +181,69 @@ func GetCmdDisbursements(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdFailedDisbursements(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "failed-disbursements",
+		Short: "Query Treasury Failed Disbursements",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/failed-disbursements", queryRoute), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve failed disbursements\n")
+				return nil
+			}
+
+			var out types.QueryResFailedDisbursements
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdDisbursementRecord(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "disbursement-record [reference]",
+		Short: "Query the history of a disbursement reference",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/disbursement-record/%s", queryRoute, args[0]), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve disbursement record - %s \n", args[0])
+				return nil
+			}
+
+			var out types.DisbursementRecord
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdPendingDisbursements(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-disbursements",
+		Short: "Query Treasury Disbursements waiting for manager approvals",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/pending-disbursements", queryRoute), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve pending disbursements\n")
+				return nil
+			}
+
+			var out types.QueryResPendingDisbursements
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 func GetCmdQueryPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "price [amount]",
@@ -153,6 +268,109 @@ func GetCmdQueryPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdQueryQuote(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "quote [amount]",
+		Short: "Simulate a buy (usd) or sell (anatha) order and show the per stage breakdown",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/quote/%s", queryRoute, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var quote types.Quote
+			if err := cdc.UnmarshalJSON(res, &quote); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(quote)
+		},
+	}
+}
+
+func GetCmdQueryPriceCurve(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "price-curve",
+		Short: "Query the active and scheduled price curves",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/price-curve", queryRoute), nil)
+			if err != nil {
+				fmt.Printf("Could not resolve price curve\n")
+				return nil
+			}
+
+			var out types.QueryResPriceCurve
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQuerySnapshots(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Query the treasury snapshots within a height or time range",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var fromTime, toTime time.Time
+			var err error
+
+			if value := viper.GetString(FlagFromTime); value != "" {
+				fromTime, err = time.Parse(time.RFC3339, value)
+				if err != nil {
+					return err
+				}
+			}
+
+			if value := viper.GetString(FlagToTime); value != "" {
+				toTime, err = time.Parse(time.RFC3339, value)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := types.NewQuerySnapshotsParams(
+				viper.GetInt64(FlagFromHeight),
+				viper.GetInt64(FlagToHeight),
+				fromTime,
+				toTime,
+				viper.GetInt(FlagLimit),
+			)
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/snapshots", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResSnapshots
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "First block height to include")
+	cmd.Flags().Int64(FlagToHeight, 0, "Last block height to include")
+	cmd.Flags().String(FlagFromTime, "", "Earliest block time to include (RFC3339)")
+	cmd.Flags().String(FlagToTime, "", "Latest block time to include (RFC3339)")
+	cmd.Flags().Int(FlagLimit, types.MaxSnapshotQueryResults, "Maximum number of snapshots to return")
+
+	return cmd
+}
+
 func GetCmdQueryDisbursementEscrow(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "disbursement-escrow [reference]",
@@ -31,6 +31,12 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdOrder(cdc),
 		GetCmdDisburse(cdc),
 		GetCmdCancelDisbursement(cdc),
+		GetCmdCancelDisbursementByReference(cdc),
+		GetCmdCancelOperatorDisbursements(cdc),
+		GetCmdRetryDisbursement(cdc),
+		GetCmdAbandonDisbursement(cdc),
+		GetCmdApproveDisbursement(cdc),
+		GetCmdVetoDisbursement(cdc),
 		GetCmdDisburseToEscrow(cdc),
 		GetCmdDisburseFromEscrow(cdc),
 		GetCmdRevertFromEscrow(cdc),
@@ -135,6 +141,143 @@ func GetCmdCancelDisbursement(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdCancelDisbursementByReference(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-disbursement-by-reference [reference]",
+		Short: "Cancel scheduled distribution by its reference",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgCancelDisbursementByReference(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCancelOperatorDisbursements(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-operator-disbursements [operator]",
+		Short: "Cancel all scheduled distributions of an operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			operator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelOperatorDisbursements(cliCtx.GetFromAddress(), operator)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRetryDisbursement(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "retry-disbursement [reference]",
+		Short: "Retry failed disbursement",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRetryDisbursement(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdAbandonDisbursement(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "abandon-disbursement [reference]",
+		Short: "Abandon failed disbursement",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgAbandonDisbursement(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdApproveDisbursement(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "approve-disbursement [reference]",
+		Short: "Approve pending disbursement",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgApproveDisbursement(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdVetoDisbursement(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "veto-disbursement [reference]",
+		Short: "Veto pending disbursement",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgVetoDisbursement(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 func GetCmdDisburseToEscrow(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "disburse-to-escrow [amount] [reference]",
@@ -10,4 +10,5 @@ var RemoveBuyBackLiquidityProposalHandler = govclient.NewProposalHandler(cli.Get
 var BurnDistributionProfitsProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitBurnDistributionProfitsProposal)
 var TransferFromDistributionProfitsToBuyBackLiquidityProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitTransferFromDistributionProfitsToBuyBackLiquidityProposal)
 var TransferFromTreasuryToSwapEscrowProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitTransferFromTreasuryToSwapEscrowProposal)
-var TransferFromSwapEscrowToBuyBackProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitTransferFromSwapEscrowToBuyBackProposal)
\ No newline at end of file
+var TransferFromSwapEscrowToBuyBackProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitTransferFromSwapEscrowToBuyBackProposal)
+var SetPriceCurveProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSetPriceCurveProposal)
\ No newline at end of file
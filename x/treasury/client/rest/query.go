@@ -3,6 +3,8 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -17,6 +19,21 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/treasury/parameters",
 		queryParamsHandlerFn(cliCtx),
 	).Methods("GET")
+
+	r.HandleFunc(
+		"/treasury/disbursements/{reference}",
+		queryDisbursementRecordHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/treasury/quote/{amount}",
+		queryQuoteHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/treasury/snapshots",
+		querySnapshotsHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -38,3 +55,107 @@ func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func queryDisbursementRecordHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		reference := mux.Vars(r)["reference"]
+
+		route := fmt.Sprintf("custom/%s/disbursement-record/%s", types.QuerierRoute, reference)
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryQuoteHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		amount := mux.Vars(r)["amount"]
+
+		route := fmt.Sprintf("custom/%s/quote/%s", types.QuerierRoute, amount)
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query treasury snapshots, e.g. /treasury/snapshots?from_time=2020-06-01T00:00:00Z&to_height=1000
+func querySnapshotsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var params types.QuerySnapshotsParams
+		var err error
+
+		query := r.URL.Query()
+
+		for key, target := range map[string]*int64{"from_height": &params.FromHeight, "to_height": &params.ToHeight} {
+			if value := query.Get(key); value != "" {
+				*target, err = strconv.ParseInt(value, 10, 64)
+				if err != nil {
+					rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+					return
+				}
+			}
+		}
+
+		for key, target := range map[string]*time.Time{"from_time": &params.FromTime, "to_time": &params.ToTime} {
+			if value := query.Get(key); value != "" {
+				*target, err = time.Parse(time.RFC3339, value)
+				if err != nil {
+					rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+					return
+				}
+			}
+		}
+
+		if value := query.Get("limit"); value != "" {
+			params.Limit, err = strconv.Atoi(value)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/snapshots", types.QuerierRoute)
+
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
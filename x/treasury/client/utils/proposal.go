@@ -3,6 +3,7 @@ package utils
 import (
 	"github.com/DFWallet/anatha/codec"
 	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/x/treasury/internal/types"
 	"io/ioutil"
 )
 
@@ -26,3 +27,25 @@ func ParseGenericTreasuryProposalJSON(cdc *codec.Codec, proposalFile string) (Ge
 
 	return proposal, nil
 }
+
+type SetPriceCurveProposalJSON struct {
+	Title       string   				`json:"title" yaml:"title"`
+	Description string   				`json:"description" yaml:"description"`
+	FromStage 	uint64 					`json:"from_stage" yaml:"from_stage"`
+	Curve 		types.PriceCurveParams 	`json:"curve" yaml:"curve"`
+}
+
+func ParseSetPriceCurveProposalJSON(cdc *codec.Codec, proposalFile string) (SetPriceCurveProposalJSON, error) {
+	proposal := SetPriceCurveProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
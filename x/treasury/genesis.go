@@ -46,6 +46,46 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) []abci.ValidatorU
 		k.SetDisbursementReferenceAmount(ctx, disbursementReference.Reference, disbursementReference.Amount)
 	}
 
+	for _, failed := range data.FailedDisbursements {
+		k.SetFailedDisbursement(ctx, failed)
+
+		if failed.HasRetryScheduled() {
+			k.InsertDisbursementRetryQueue(ctx, failed)
+		}
+	}
+
+	for _, record := range data.DisbursementRecords {
+		k.SetDisbursementRecord(ctx, record)
+	}
+
+	for _, pending := range data.PendingDisbursements {
+		k.SetPendingDisbursement(ctx, pending)
+	}
+
+	for _, policy := range data.OperatorPolicies {
+		k.SetOperatorPolicy(ctx, policy)
+	}
+
+	for _, usage := range data.OperatorUsages {
+		k.SetOperatorUsage(ctx, usage)
+	}
+
+	for _, order := range data.LimitOrders {
+		k.SetLimitOrder(ctx, order)
+	}
+
+	for _, curve := range data.PriceCurves {
+		k.SetPriceCurve(ctx, curve)
+	}
+
+	for _, snapshot := range data.Snapshots {
+		k.SetSnapshot(ctx, snapshot)
+	}
+
+	if data.NextLimitOrderId > 0 {
+		k.SetNextLimitOrderId(ctx, data.NextLimitOrderId)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -62,5 +102,15 @@ func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
 		return false
 	})
 
-	return NewGenesisState(treasury, params, operators, disbursements, disbursementReferences)
+	failedDisbursements := k.GetFailedDisbursements(ctx)
+	disbursementRecords := k.GetDisbursementRecords(ctx)
+	pendingDisbursements := k.GetPendingDisbursements(ctx)
+	operatorPolicies := k.GetOperatorPolicies(ctx)
+	operatorUsages := k.GetOperatorUsages(ctx)
+	limitOrders := k.GetLimitOrders(ctx)
+	nextLimitOrderId := k.GetNextLimitOrderId(ctx)
+	priceCurves := k.GetPriceCurves(ctx)
+	snapshots := k.GetSnapshots(ctx)
+
+	return NewGenesisState(treasury, params, operators, disbursements, disbursementReferences, failedDisbursements, disbursementRecords, pendingDisbursements, operatorPolicies, operatorUsages, limitOrders, nextLimitOrderId, priceCurves, snapshots)
 }
@@ -20,6 +20,9 @@ func NewHandler(k Keeper) sdk.Handler {
 		case MsgRemoveOperator:
 			return handleMsgRemoveOperator(ctx, k, msg)
 
+		case MsgSetOperatorPolicy:
+			return handleMsgSetOperatorPolicy(ctx, k, msg)
+
 		case MsgDisburse:
 			return handleMsgDisburse(ctx, k, msg)
 
@@ -35,12 +38,36 @@ func NewHandler(k Keeper) sdk.Handler {
 		case MsgCancelDisbursement:
 			return handleMsgCancelDisbursement(ctx, k, msg)
 
+		case MsgCancelDisbursementByReference:
+			return handleMsgCancelDisbursementByReference(ctx, k, msg)
+
+		case MsgCancelOperatorDisbursements:
+			return handleMsgCancelOperatorDisbursements(ctx, k, msg)
+
+		case MsgRetryDisbursement:
+			return handleMsgRetryDisbursement(ctx, k, msg)
+
+		case MsgAbandonDisbursement:
+			return handleMsgAbandonDisbursement(ctx, k, msg)
+
+		case MsgApproveDisbursement:
+			return handleMsgApproveDisbursement(ctx, k, msg)
+
+		case MsgVetoDisbursement:
+			return handleMsgVetoDisbursement(ctx, k, msg)
+
 		case MsgCreateSellOrder:
 			return handleMsgCreateSellOrder(ctx, k, msg)
 
 		case MsgCreateBuyOrder:
 			return handleMsgCreateBuyOrder(ctx, k, msg)
 
+		case MsgCreateLimitOrder:
+			return handleMsgCreateLimitOrder(ctx, k, msg)
+
+		case MsgCancelLimitOrder:
+			return handleMsgCancelLimitOrder(ctx, k, msg)
+
 		case MsgSwap:
 			return handleMsgSwap(ctx, k, msg)
 
@@ -72,6 +99,9 @@ func NewTreasuryProposalHandler(k Keeper) govtypes.Handler {
 			case TransferFromSwapEscrowToBuyBackProposal:
 				return handleTransferSwapEscrowToBuyBackProposal(ctx, k, c)
 
+			case SetPriceCurveProposal:
+				return handleSetPriceCurveProposal(ctx, k, c)
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized treasury proposal content type: %T", c)
 		}
@@ -192,6 +222,26 @@ func handleTransferSwapEscrowToBuyBackProposal(ctx sdk.Context, k Keeper, p Tran
 	return nil
 }
 
+func handleSetPriceCurveProposal(ctx sdk.Context, k Keeper, p SetPriceCurveProposal) error {
+	err := k.SchedulePriceCurve(ctx, p.FromStage, p.Curve)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetPriceCurve,
+			sdk.NewAttribute(types.AttributeKeyTitle, p.Title),
+			sdk.NewAttribute(types.AttributeKeyDescription, p.Description),
+			sdk.NewAttribute(types.AttributeKeyFromStage, fmt.Sprintf("%d", p.FromStage)),
+			sdk.NewAttribute(types.AttributeKeyPriceCurve, p.Curve.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+		),
+	)
+
+	return nil
+}
+
 func handleMsgAddOperator(ctx sdk.Context, k Keeper, msg MsgAddOperator) (*sdk.Result, error) {
 	err := k.HandleAddOperator(ctx, msg.Sender, msg.Operator)
 	if err != nil {
@@ -210,6 +260,15 @@ func handleMsgRemoveOperator(ctx sdk.Context, k Keeper, msg MsgRemoveOperator) (
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgSetOperatorPolicy(ctx sdk.Context, k Keeper, msg MsgSetOperatorPolicy) (*sdk.Result, error) {
+	err := k.HandleSetOperatorPolicy(ctx, msg.Sender, msg.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgDisburse(ctx sdk.Context, k Keeper, msg MsgDisburse) (*sdk.Result, error) {
 	err := k.HandleDisburse(ctx, msg.Operator, msg.Recipient, msg.Amount, msg.Reference)
 	if err != nil {
@@ -260,8 +319,62 @@ func handleMsgCancelDisbursement(ctx sdk.Context, k Keeper, msg MsgCancelDisburs
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgCancelDisbursementByReference(ctx sdk.Context, k Keeper, msg MsgCancelDisbursementByReference) (*sdk.Result, error) {
+	err := k.HandleCancelDisbursementByReference(ctx, msg.Manager, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelOperatorDisbursements(ctx sdk.Context, k Keeper, msg MsgCancelOperatorDisbursements) (*sdk.Result, error) {
+	err := k.HandleCancelOperatorDisbursements(ctx, msg.Manager, msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRetryDisbursement(ctx sdk.Context, k Keeper, msg MsgRetryDisbursement) (*sdk.Result, error) {
+	err := k.HandleRetryDisbursement(ctx, msg.Manager, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAbandonDisbursement(ctx sdk.Context, k Keeper, msg MsgAbandonDisbursement) (*sdk.Result, error) {
+	err := k.HandleAbandonDisbursement(ctx, msg.Manager, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgApproveDisbursement(ctx sdk.Context, k Keeper, msg MsgApproveDisbursement) (*sdk.Result, error) {
+	err := k.HandleApproveDisbursement(ctx, msg.Manager, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgVetoDisbursement(ctx sdk.Context, k Keeper, msg MsgVetoDisbursement) (*sdk.Result, error) {
+	err := k.HandleVetoDisbursement(ctx, msg.Manager, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgCreateSellOrder(ctx sdk.Context, k Keeper, msg MsgCreateSellOrder) (*sdk.Result, error) {
-	err := k.HandleCreateSellOrder(ctx, msg.Seller, msg.Amount)
+	err := k.HandleCreateSellOrder(ctx, msg.Seller, msg.Amount, msg.MinReceived)
 	if err != nil {
 		return nil, err
 	}
@@ -270,7 +383,25 @@ func handleMsgCreateSellOrder(ctx sdk.Context, k Keeper, msg MsgCreateSellOrder)
 }
 
 func handleMsgCreateBuyOrder(ctx sdk.Context, k Keeper, msg MsgCreateBuyOrder) (*sdk.Result, error) {
-	err := k.HandleCreateBuyOrder(ctx, msg.Buyer, msg.Amount)
+	err := k.HandleCreateBuyOrder(ctx, msg.Buyer, msg.Amount, msg.MinReceived)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCreateLimitOrder(ctx sdk.Context, k Keeper, msg MsgCreateLimitOrder) (*sdk.Result, error) {
+	err := k.HandleCreateLimitOrder(ctx, msg.Owner, msg.Side, msg.Amount, msg.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelLimitOrder(ctx sdk.Context, k Keeper, msg MsgCancelLimitOrder) (*sdk.Result, error) {
+	err := k.HandleCancelLimitOrder(ctx, msg.Owner, msg.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/x/treasury/internal/types"
+)
+
+// RequiredApprovals returns the number of manager approvals the amount needs, the highest matching tier applies
+func (k Keeper) RequiredApprovals(ctx sdk.Context, dinAmount sdk.Coins) uint16 {
+	required := uint16(0)
+
+	for _, tier := range k.ApprovalTiers(ctx) {
+		if dinAmount.IsAnyGTE(tier.Amount) && tier.Approvals > required {
+			required = tier.Approvals
+		}
+	}
+
+	return k.capApprovals(ctx, required)
+}
+
+// capApprovals limits the required approvals to the current number of managers,
+// so pending disbursements stay approvable after the managers change
+func (k Keeper) capApprovals(ctx sdk.Context, required uint16) uint16 {
+	managers := uint16(len(k.Managers(ctx)))
+	if required > managers {
+		return managers
+	}
+
+	return required
+}
+
+// HandleApproveDisbursement co-signs a pending disbursement, the last required approval moves it to the disbursement queue
+func (k Keeper) HandleApproveDisbursement(ctx sdk.Context, manager sdk.AccAddress, reference string) error {
+	if ! k.IsManager(ctx, manager) {
+		return types.ErrNotManager
+	}
+
+	pending, found := k.GetPendingDisbursement(ctx, reference)
+	if ! found {
+		return types.ErrPendingDisbursementNotFound
+	}
+
+	if pending.HasApproved(manager) {
+		return types.ErrAlreadyApproved
+	}
+
+	pending.Approvals = append(pending.Approvals, manager)
+
+	approvals := k.countApprovals(ctx, pending)
+	requiredApprovals := k.capApprovals(ctx, pending.RequiredApprovals)
+
+	if approvals >= int(requiredApprovals) {
+		k.DeletePendingDisbursement(ctx, reference)
+
+		disbursement := pending.Disbursement
+
+		if disbursement.ScheduledFor.Before(ctx.BlockTime()) {
+			disbursement.ScheduledFor = ctx.BlockTime()
+		}
+
+		for k.HasDisbursementInQueue(ctx, disbursement.Recipient, disbursement.ScheduledFor) {
+			disbursement.ScheduledFor = disbursement.ScheduledFor.Add(time.Millisecond)
+		}
+
+		k.InsertDisbursementQueue(ctx, disbursement)
+
+		k.UpdateDisbursementRecord(ctx, reference, types.DisbursementStatusScheduled, disbursement.Amount, disbursement.ScheduledFor.String())
+	} else {
+		k.SetPendingDisbursement(ctx, pending)
+
+		k.UpdateDisbursementRecord(ctx, reference, types.DisbursementStatusPendingApproval, pending.Disbursement.Amount, manager.String())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApproveDisbursement,
+			sdk.NewAttribute(types.AttributeKeyReference, reference),
+			sdk.NewAttribute(types.AttributeKeyApprovals, fmt.Sprintf("%d", approvals)),
+			sdk.NewAttribute(types.AttributeKeyRequiredApprovals, fmt.Sprintf("%d", requiredApprovals)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, manager.String()),
+		),
+	})
+
+	return nil
+}
+
+// HandleVetoDisbursement drops a pending disbursement, the reference stays used
+func (k Keeper) HandleVetoDisbursement(ctx sdk.Context, manager sdk.AccAddress, reference string) error {
+	if ! k.IsManager(ctx, manager) {
+		return types.ErrNotManager
+	}
+
+	pending, found := k.GetPendingDisbursement(ctx, reference)
+	if ! found {
+		return types.ErrPendingDisbursementNotFound
+	}
+
+	k.DeletePendingDisbursement(ctx, reference)
+
+	k.RefundOperatorAllowance(ctx, pending.Disbursement.Operator, reference)
+
+	k.UpdateDisbursementRecord(ctx, reference, types.DisbursementStatusVetoed, pending.Disbursement.Amount, manager.String())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVetoDisbursement,
+			sdk.NewAttribute(types.AttributeKeyRecipient, pending.Disbursement.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, pending.Disbursement.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReference, reference),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, manager.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) SetPendingDisbursement(ctx sdk.Context, pending types.PendingDisbursement) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetPendingDisbursementKey(pending.Disbursement.Reference), k.cdc.MustMarshalBinaryBare(pending))
+}
+
+func (k Keeper) GetPendingDisbursement(ctx sdk.Context, reference string) (types.PendingDisbursement, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var pending types.PendingDisbursement
+
+	bz := store.Get(types.GetPendingDisbursementKey(reference))
+	if bz == nil {
+		return pending, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &pending)
+
+	return pending, true
+}
+
+func (k Keeper) DeletePendingDisbursement(ctx sdk.Context, reference string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetPendingDisbursementKey(reference))
+}
+
+func (k Keeper) IteratePendingDisbursements(ctx sdk.Context, cb func(pending types.PendingDisbursement) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingDisbursementKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingDisbursement
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &pending)
+
+		if cb(pending) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetPendingDisbursements(ctx sdk.Context) []types.PendingDisbursement {
+	pendingDisbursements := make([]types.PendingDisbursement, 0)
+	k.IteratePendingDisbursements(ctx, func(pending types.PendingDisbursement) (stop bool) {
+		pendingDisbursements = append(pendingDisbursements, pending)
+		return false
+	})
+
+	return pendingDisbursements
+}
+
+// countApprovals only counts the approvals of current managers, so removing a manager also withdraws its approvals
+func (k Keeper) countApprovals(ctx sdk.Context, pending types.PendingDisbursement) int {
+	count := 0
+
+	for _, approval := range pending.Approvals {
+		if k.IsManager(ctx, approval) {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/DFWallet/anatha/types"
+	sdkerrors "github.com/DFWallet/anatha/types/errors"
+	"github.com/DFWallet/project-anatha/x/treasury/internal/types"
+)
+
+// SchedulePriceCurve prices all stages from fromStage with the given curve, stages that were already reached keep their price
+func (k Keeper) SchedulePriceCurve(ctx sdk.Context, fromStage uint64, params types.PriceCurveParams) error {
+	err := params.Validate()
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidPriceCurve, err.Error())
+	}
+
+	currentStage := k.GetStageFromDistribution(ctx, k.DistributedFromTreasury(ctx))
+	if sdk.NewIntFromUint64(fromStage).LT(currentStage) {
+		return sdkerrors.Wrapf(types.ErrInvalidPriceCurve, "stage %d was already reached, current stage is %s", fromStage, currentStage)
+	}
+
+	// curves scheduled after the new one are superseded by it
+	for _, curve := range k.GetPriceCurves(ctx) {
+		if curve.FromStage > fromStage {
+			k.DeletePriceCurve(ctx, curve.FromStage)
+		}
+	}
+
+	k.SetPriceCurve(ctx, types.NewScheduledPriceCurve(fromStage, params))
+
+	return nil
+}
+
+// GetPriceCurveForStage returns the curve pricing the given stage
+func (k Keeper) GetPriceCurveForStage(ctx sdk.Context, stage sdk.Int) types.ScheduledPriceCurve {
+	store := ctx.KVStore(k.storeKey)
+
+	end := types.GetPriceCurveKey(^uint64(0))
+	if stage.IsUint64() {
+		end = types.GetPriceCurveKey(stage.Uint64())
+	}
+
+	iterator := store.ReverseIterator(types.PriceCurveKeyPrefix, sdk.PrefixEndBytes(end))
+
+	defer iterator.Close()
+
+	if ! iterator.Valid() {
+		return types.DefaultScheduledPriceCurve()
+	}
+
+	var curve types.ScheduledPriceCurve
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &curve)
+
+	return curve
+}
+
+func (k Keeper) SetPriceCurve(ctx sdk.Context, curve types.ScheduledPriceCurve) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetPriceCurveKey(curve.FromStage), k.cdc.MustMarshalBinaryBare(curve))
+}
+
+func (k Keeper) DeletePriceCurve(ctx sdk.Context, fromStage uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetPriceCurveKey(fromStage))
+}
+
+func (k Keeper) GetPriceCurves(ctx sdk.Context) []types.ScheduledPriceCurve {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PriceCurveKeyPrefix)
+
+	defer iterator.Close()
+
+	curves := make([]types.ScheduledPriceCurve, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var curve types.ScheduledPriceCurve
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &curve)
+
+		curves = append(curves, curve)
+	}
+
+	return curves
+}
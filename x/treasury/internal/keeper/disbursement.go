@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"fmt"
 	"github.com/DFWallet/project-anatha/config"
+	"strings"
 	"time"
 
 	sdk "github.com/DFWallet/anatha/types"
@@ -17,6 +19,13 @@ func (k Keeper) HandleDisburse(ctx sdk.Context, operator sdk.AccAddress, recipie
 		return types.ErrDuplicateReference
 	}
 
+	pinAmount, _, _ := k.CalculatePinAmountExtended(ctx, dinAmount)
+
+	err := k.ConsumeOperatorAllowance(ctx, operator, recipient, dinAmount.Add(pinAmount...), reference)
+	if err != nil {
+		return err
+	}
+
 	scheduledFor := ctx.BlockTime()
 
 	if dinAmount.IsAnyGTE(k.RiskAssessmentAmount(ctx)) {
@@ -27,16 +36,28 @@ func (k Keeper) HandleDisburse(ctx sdk.Context, operator sdk.AccAddress, recipie
 		scheduledFor = scheduledFor.Add(time.Millisecond)
 	}
 
-	k.InsertDisbursementQueue(ctx, types.NewDisbursement(
+	disbursement := types.NewDisbursement(
 		operator,
 		recipient,
 		dinAmount,
 		scheduledFor,
 		reference,
-	))
+	)
+
+	status := types.DisbursementStatusScheduled
+
+	requiredApprovals := k.RequiredApprovals(ctx, dinAmount)
+	if requiredApprovals > 0 {
+		status = types.DisbursementStatusPendingApproval
+		k.SetPendingDisbursement(ctx, types.NewPendingDisbursement(disbursement, requiredApprovals))
+	} else {
+		k.InsertDisbursementQueue(ctx, disbursement)
+	}
 
 	k.SetDisbursementReferenceAmount(ctx, reference, sdk.ZeroInt())
 
+	k.OpenDisbursementRecord(ctx, reference, operator, recipient, dinAmount, status, dinAmount, scheduledFor.String())
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeDisburse,
@@ -44,6 +65,7 @@ func (k Keeper) HandleDisburse(ctx sdk.Context, operator sdk.AccAddress, recipie
 			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
 			sdk.NewAttribute(types.AttributeKeyAmount, dinAmount.String()),
 			sdk.NewAttribute(types.AttributeKeyReference, reference),
+			sdk.NewAttribute(types.AttributeKeyRequiredApprovals, fmt.Sprintf("%d", requiredApprovals)),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -70,11 +92,18 @@ func (k Keeper) HandleDisburseToEscrow(ctx sdk.Context, operator sdk.AccAddress,
 
 	totalPinAmount, fromBuyBack, fromTreasury := k.CalculatePinAmountExtended(ctx, dinAmount)
 
-	err := k.DisburseFundsToEscrow(ctx, reference, dinAmount, fromBuyBack, fromTreasury)
+	err := k.ConsumeOperatorAllowance(ctx, operator, sdk.AccAddress{}, dinAmount.Add(totalPinAmount...), reference)
+	if err != nil {
+		return err
+	}
+
+	err = k.DisburseFundsToEscrow(ctx, reference, dinAmount, fromBuyBack, fromTreasury)
 	if err != nil {
 		return err
 	}
 
+	k.OpenDisbursementRecord(ctx, reference, operator, sdk.AccAddress{}, dinAmount, types.DisbursementStatusEscrowed, totalPinAmount, "")
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeDisburseToEscrow,
@@ -107,11 +136,24 @@ func (k Keeper) HandleDisburseFromEscrow(ctx sdk.Context, operator sdk.AccAddres
 		return types.ErrEscrowDisbursed
 	}
 
-	err := k.DisburseFundsFromEscrow(ctx, reference, amount, recipient)
+	err := k.CheckOperatorRecipient(ctx, operator, recipient)
+	if err != nil {
+		return err
+	}
+
+	err = k.DisburseFundsFromEscrow(ctx, reference, amount, recipient)
 	if err != nil {
 		return err
 	}
 
+	record, found := k.GetDisbursementRecord(ctx, reference)
+	if found {
+		record.Recipient = recipient
+		k.SetDisbursementRecord(ctx, record)
+	}
+
+	k.UpdateDisbursementRecord(ctx, reference, types.DisbursementStatusPaid, sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, amount)), recipient.String())
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeDisburseFromEscrow,
@@ -159,6 +201,13 @@ func (k Keeper) HandleRevertFromEscrow(ctx sdk.Context, operator sdk.AccAddress,
 
 	k.SetDisbursementReferenceAmount(ctx, reference, escrowRemainder)
 
+	status := types.DisbursementStatusPartiallyReverted
+	if escrowRemainder.IsZero() {
+		status = types.DisbursementStatusReverted
+	}
+
+	k.UpdateDisbursementRecord(ctx, reference, status, amount, escrowRemainder.String())
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeRevertFromEscrow,
@@ -181,17 +230,89 @@ func (k Keeper) HandleCancelDisbursement(ctx sdk.Context, manager sdk.AccAddress
 		return types.ErrNotManager
 	}
 
-	if ! k.HasDisbursementInQueue(ctx, recipient, scheduledFor) {
+	disbursement, found := k.GetScheduledDisbursement(ctx, recipient, scheduledFor)
+	if ! found {
 		return types.ErrDisbursementNotScheduled
 	}
 
-	k.RemoveFromDisbursementQueue(ctx, recipient, scheduledFor)
+	k.cancelDisbursement(ctx, manager, disbursement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, manager.String()),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) HandleCancelDisbursementByReference(ctx sdk.Context, manager sdk.AccAddress, reference string) error {
+	if ! k.IsManager(ctx, manager) {
+		return types.ErrNotManager
+	}
+
+	disbursement, found := k.GetScheduledDisbursementByReference(ctx, reference)
+	if found {
+		k.cancelDisbursement(ctx, manager, disbursement)
+	} else {
+		pending, found := k.GetPendingDisbursement(ctx, reference)
+		if ! found {
+			return types.ErrDisbursementNotScheduled
+		}
+
+		k.DeletePendingDisbursement(ctx, reference)
+		k.cancelDisbursement(ctx, manager, pending.Disbursement)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, manager.String()),
+		),
+	)
+
+	return nil
+}
+
+// HandleCancelOperatorDisbursements cancels everything the operator has queued or waiting for approvals
+func (k Keeper) HandleCancelOperatorDisbursements(ctx sdk.Context, manager sdk.AccAddress, operator sdk.AccAddress) error {
+	if ! k.IsManager(ctx, manager) {
+		return types.ErrNotManager
+	}
+
+	var disbursements []types.Disbursement
+	k.IterateDisbursementQueue(ctx, func(disbursement types.Disbursement) (stop bool) {
+		if disbursement.Operator.Equals(operator) {
+			disbursements = append(disbursements, disbursement)
+		}
+		return false
+	})
+
+	var pendingDisbursements []types.PendingDisbursement
+	k.IteratePendingDisbursements(ctx, func(pending types.PendingDisbursement) (stop bool) {
+		if pending.Disbursement.Operator.Equals(operator) {
+			pendingDisbursements = append(pendingDisbursements, pending)
+		}
+		return false
+	})
+
+	for _, disbursement := range disbursements {
+		k.cancelDisbursement(ctx, manager, disbursement)
+	}
+
+	for _, pending := range pendingDisbursements {
+		k.DeletePendingDisbursement(ctx, pending.Disbursement.Reference)
+		k.cancelDisbursement(ctx, manager, pending.Disbursement)
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeCancelDisbursement,
-			sdk.NewAttribute(types.AttributeKeyScheduledFor, scheduledFor.String()),
-			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			types.EventTypeCancelOperatorDisbursements,
+			sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+			sdk.NewAttribute(types.AttributeKeyCount, fmt.Sprintf("%d", len(disbursements) + len(pendingDisbursements))),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -203,6 +324,26 @@ func (k Keeper) HandleCancelDisbursement(ctx sdk.Context, manager sdk.AccAddress
 	return nil
 }
 
+// cancelDisbursement drops the disbursement from the queue and releases its reference so it can be re-issued
+func (k Keeper) cancelDisbursement(ctx sdk.Context, manager sdk.AccAddress, disbursement types.Disbursement) {
+	k.RemoveFromDisbursementQueue(ctx, disbursement.Recipient, disbursement.ScheduledFor)
+
+	k.RemoveDisbursementReferenceAmount(ctx, disbursement.Reference)
+
+	k.RefundOperatorAllowance(ctx, disbursement.Operator, disbursement.Reference)
+
+	k.UpdateDisbursementRecord(ctx, disbursement.Reference, types.DisbursementStatusCancelled, disbursement.Amount, manager.String())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelDisbursement,
+			sdk.NewAttribute(types.AttributeKeyScheduledFor, disbursement.ScheduledFor.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, disbursement.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyReference, disbursement.Reference),
+		),
+	)
+}
+
 func (k Keeper) DisburseFunds(ctx sdk.Context, operator sdk.AccAddress, recipient sdk.AccAddress, dinAmount sdk.Coins, fromBuyBack sdk.Coins, fromTreasury sdk.Coins) error {
 	if ! operator.Empty() && ! k.IsOperator(ctx, operator) {
 		return types.ErrNotOperator
@@ -299,6 +440,39 @@ func (keeper Keeper) HasDisbursementInQueue(ctx sdk.Context, recipient sdk.AccAd
 	return store.Has(types.DisbursementQueueKey(recipient, scheduledFor))
 }
 
+func (keeper Keeper) GetScheduledDisbursement(ctx sdk.Context, recipient sdk.AccAddress, scheduledFor time.Time) (types.Disbursement, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var disbursement types.Disbursement
+
+	bz := store.Get(types.DisbursementQueueKey(recipient, scheduledFor))
+	if bz == nil {
+		return disbursement, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &disbursement)
+
+	return disbursement, true
+}
+
+func (k Keeper) GetScheduledDisbursementByReference(ctx sdk.Context, reference string) (types.Disbursement, bool) {
+	reference = strings.ToLower(reference)
+
+	var result types.Disbursement
+	found := false
+
+	k.IterateDisbursementQueue(ctx, func(disbursement types.Disbursement) (stop bool) {
+		if strings.ToLower(disbursement.Reference) == reference {
+			result = disbursement
+			found = true
+			return true
+		}
+		return false
+	})
+
+	return result, found
+}
+
 func (keeper Keeper) ScheduledDisbursementQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(keeper.storeKey)
 	return store.Iterator(types.DisbursementQueueKeyPrefix, sdk.PrefixEndBytes(types.DisbursementByTimeKey(endTime)))
@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/x/treasury/internal/types"
+)
+
+// ExecuteDisbursement pays out a scheduled disbursement in a cached context, so a failed payout leaves no partial transfers behind
+func (k Keeper) ExecuteDisbursement(ctx sdk.Context, operator sdk.AccAddress, disbursement types.Disbursement) error {
+	cacheCtx, write := ctx.CacheContext()
+
+	totalPinAmount, fromBuyBack, fromTreasury := k.CalculatePinAmountExtended(cacheCtx, disbursement.Amount)
+
+	err := k.DisburseFunds(cacheCtx, operator, disbursement.Recipient, disbursement.Amount, fromBuyBack, fromTreasury)
+	if err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	k.UpdateDisbursementRecord(ctx, disbursement.Reference, types.DisbursementStatusPaid, totalPinAmount, "")
+
+	return nil
+}
+
+// HandleFailedDisbursement stores the disbursement with the failure reason and schedules the next automatic retry
+func (k Keeper) HandleFailedDisbursement(ctx sdk.Context, disbursement types.Disbursement, reason error) {
+	attempts := uint16(1)
+
+	failed, found := k.GetFailedDisbursement(ctx, disbursement.Reference)
+	if found {
+		k.RemoveFromDisbursementRetryQueue(ctx, failed)
+		attempts = failed.Attempts + 1
+	}
+
+	failed = types.NewFailedDisbursement(disbursement, reason.Error(), attempts, ctx.BlockTime(), time.Time{})
+
+	if attempts <= k.DisbursementMaxRetries(ctx) {
+		failed.NextRetry = ctx.BlockTime().Add(k.DisbursementRetryBackoff(ctx) * time.Duration(1 << (attempts - 1)))
+	}
+
+	k.SetFailedDisbursement(ctx, failed)
+
+	k.UpdateDisbursementRecord(ctx, disbursement.Reference, types.DisbursementStatusFailed, disbursement.Amount, failed.Reason)
+
+	if failed.HasRetryScheduled() {
+		k.InsertDisbursementRetryQueue(ctx, failed)
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("disbursement %s failed (attempt %d): %s", disbursement.Reference, attempts, reason.Error()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisbursementFailed,
+			sdk.NewAttribute(types.AttributeKeyRecipient, disbursement.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, disbursement.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReference, disbursement.Reference),
+			sdk.NewAttribute(types.AttributeKeyReason, failed.Reason),
+			sdk.NewAttribute(types.AttributeKeyAttempts, fmt.Sprintf("%d", failed.Attempts)),
+			sdk.NewAttribute(types.AttributeKeyNextRetry, failed.NextRetry.String()),
+		),
+	)
+}
+
+// RetryFailedDisbursement attempts the payout again, a successful payout clears the failed entry
+func (k Keeper) RetryFailedDisbursement(ctx sdk.Context, failed types.FailedDisbursement, operator sdk.AccAddress) error {
+	err := k.ExecuteDisbursement(ctx, operator, failed.Disbursement)
+	if err != nil {
+		return err
+	}
+
+	k.RemoveFromDisbursementRetryQueue(ctx, failed)
+	k.DeleteFailedDisbursement(ctx, failed.Disbursement.Reference)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRetryDisbursement,
+			sdk.NewAttribute(types.AttributeKeyRecipient, failed.Disbursement.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, failed.Disbursement.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReference, failed.Disbursement.Reference),
+		),
+	)
+
+	return nil
+}
+
+// HandleRetryDisbursement retries a failed disbursement immediately, the manager vouches for it so the original operator is not checked again
+func (k Keeper) HandleRetryDisbursement(ctx sdk.Context, manager sdk.AccAddress, reference string) error {
+	if ! k.IsManager(ctx, manager) {
+		return types.ErrNotManager
+	}
+
+	failed, found := k.GetFailedDisbursement(ctx, reference)
+	if ! found {
+		return types.ErrFailedDisbursementNotFound
+	}
+
+	err := k.RetryFailedDisbursement(ctx, failed, sdk.AccAddress{})
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, manager.String()),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) HandleAbandonDisbursement(ctx sdk.Context, manager sdk.AccAddress, reference string) error {
+	if ! k.IsManager(ctx, manager) {
+		return types.ErrNotManager
+	}
+
+	failed, found := k.GetFailedDisbursement(ctx, reference)
+	if ! found {
+		return types.ErrFailedDisbursementNotFound
+	}
+
+	k.RemoveFromDisbursementRetryQueue(ctx, failed)
+	k.DeleteFailedDisbursement(ctx, reference)
+
+	k.RefundOperatorAllowance(ctx, failed.Disbursement.Operator, reference)
+
+	k.UpdateDisbursementRecord(ctx, reference, types.DisbursementStatusAbandoned, failed.Disbursement.Amount, manager.String())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAbandonDisbursement,
+			sdk.NewAttribute(types.AttributeKeyRecipient, failed.Disbursement.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, failed.Disbursement.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReference, reference),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, manager.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) SetFailedDisbursement(ctx sdk.Context, failed types.FailedDisbursement) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetFailedDisbursementKey(failed.Disbursement.Reference), k.cdc.MustMarshalBinaryBare(failed))
+}
+
+func (k Keeper) GetFailedDisbursement(ctx sdk.Context, reference string) (types.FailedDisbursement, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var failed types.FailedDisbursement
+
+	bz := store.Get(types.GetFailedDisbursementKey(reference))
+	if bz == nil {
+		return failed, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &failed)
+
+	return failed, true
+}
+
+func (k Keeper) DeleteFailedDisbursement(ctx sdk.Context, reference string) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetFailedDisbursementKey(reference))
+}
+
+func (k Keeper) IterateFailedDisbursements(ctx sdk.Context, cb func(failed types.FailedDisbursement) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FailedDisbursementKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var failed types.FailedDisbursement
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &failed)
+
+		if cb(failed) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetFailedDisbursements(ctx sdk.Context) []types.FailedDisbursement {
+	failedDisbursements := make([]types.FailedDisbursement, 0)
+	k.IterateFailedDisbursements(ctx, func(failed types.FailedDisbursement) (stop bool) {
+		failedDisbursements = append(failedDisbursements, failed)
+		return false
+	})
+
+	return failedDisbursements
+}
+
+func (k Keeper) InsertDisbursementRetryQueue(ctx sdk.Context, failed types.FailedDisbursement) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DisbursementRetryQueueKey(failed.Disbursement.Reference, failed.NextRetry), []byte(failed.Disbursement.Reference))
+}
+
+func (k Keeper) RemoveFromDisbursementRetryQueue(ctx sdk.Context, failed types.FailedDisbursement) {
+	if ! failed.HasRetryScheduled() {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DisbursementRetryQueueKey(failed.Disbursement.Reference, failed.NextRetry))
+}
+
+// GetDueFailedDisbursements returns the failed disbursements whose retry is due at the given time
+func (k Keeper) GetDueFailedDisbursements(ctx sdk.Context, endTime time.Time) []types.FailedDisbursement {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.DisbursementRetryQueueKeyPrefix, sdk.PrefixEndBytes(types.DisbursementRetryByTimeKey(endTime)))
+
+	defer iterator.Close()
+
+	var due []types.FailedDisbursement
+	for ; iterator.Valid(); iterator.Next() {
+		failed, found := k.GetFailedDisbursement(ctx, string(iterator.Value()))
+		if found {
+			due = append(due, failed)
+		}
+	}
+
+	return due
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/config"
+	"github.com/DFWallet/project-anatha/x/treasury/internal/types"
+)
+
+// RegisterInvariants registers all treasury invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "distributed",
+		DistributedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrow-references",
+		EscrowReferencesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-accounts",
+		ModuleAccountsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "disbursement-references",
+		DisbursementReferencesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the treasury module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := DistributedInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = EscrowReferencesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = ModuleAccountsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return DisbursementReferencesInvariant(k)(ctx)
+	}
+}
+
+// DistributedInvariant checks that the pin recorded as distributed is exactly what left the treasury module account
+func DistributedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		treasury := k.GetTreasury(ctx)
+
+		target := treasury.TargetSupply.AmountOf(config.DefaultDenom)
+		distributed := treasury.Distributed.AmountOf(config.DefaultDenom)
+		balance := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().AmountOf(config.DefaultDenom)
+
+		broken := ! balance.Add(distributed).Equal(target)
+
+		return sdk.FormatInvariant(types.ModuleName, "distributed", fmt.Sprintf(
+			"\ttreasury balance + distributed should equal the target supply\n"+
+				"\ttreasury balance: %s\n"+
+				"\tdistributed: %s\n"+
+				"\ttarget supply: %s\n", balance, distributed, target)), broken
+	}
+}
+
+// EscrowReferencesInvariant checks that the outstanding escrow reference amounts add up to the treasury escrow balance
+func EscrowReferencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		outstanding := sdk.ZeroInt()
+		k.IterateDisbursementReferences(ctx, func(reference string, amount sdk.Int) (stop bool) {
+			outstanding = outstanding.Add(amount)
+			return false
+		})
+
+		balance := k.supplyKeeper.GetModuleAccount(ctx, types.TreasuryEscrowModuleName).GetCoins().AmountOf(config.DefaultDenom)
+
+		broken := ! outstanding.Equal(balance)
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow-references", fmt.Sprintf(
+			"\tsum of escrow reference amounts should equal the treasury escrow balance\n"+
+				"\treference amounts: %s\n"+
+				"\tescrow balance: %s\n", outstanding, balance)), broken
+	}
+}
+
+// ModuleAccountsInvariant checks that the treasury module accounts hold valid balances and
+// that the order book holds exactly the funds of the resting limit orders
+func ModuleAccountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		moduleAccounts := []string{
+			types.ModuleName,
+			types.BuyBackLiquidityFundModuleName,
+			types.BuyBackFundModuleName,
+			types.DistributionProfitsModuleName,
+			types.TreasuryEscrowModuleName,
+			types.SwapEscrowModuleName,
+			types.OrderBookModuleName,
+		}
+
+		for _, name := range moduleAccounts {
+			coins := k.supplyKeeper.GetModuleAccount(ctx, name).GetCoins()
+			if ! coins.IsValid() {
+				broken = true
+				msg += fmt.Sprintf("\t%s has an invalid balance: %s\n", name, coins)
+			}
+		}
+
+		resting := sdk.NewCoins()
+		for _, order := range k.GetLimitOrders(ctx) {
+			resting = resting.Add(order.Amount...)
+		}
+
+		orderBook := k.supplyKeeper.GetModuleAccount(ctx, types.OrderBookModuleName).GetCoins()
+		if ! orderBook.IsAllGTE(resting) || ! resting.IsAllGTE(orderBook) {
+			broken = true
+			msg += fmt.Sprintf("\torder book balance %s does not match the resting limit orders %s\n", orderBook, resting)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "module-accounts", msg), broken
+	}
+}
+
+// DisbursementReferencesInvariant checks that every queued and pending disbursement has its reference recorded
+func DisbursementReferencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		check := func(disbursement types.Disbursement) {
+			if ! k.IsDisbursementReferenceSet(ctx, strings.ToLower(disbursement.Reference)) {
+				count++
+				msg += fmt.Sprintf("\tdisbursement to %s scheduled for %s has no recorded reference %s\n", disbursement.Recipient, disbursement.ScheduledFor, disbursement.Reference)
+			}
+		}
+
+		k.IterateDisbursementQueue(ctx, func(disbursement types.Disbursement) (stop bool) {
+			check(disbursement)
+			return false
+		})
+
+		k.IteratePendingDisbursements(ctx, func(pending types.PendingDisbursement) (stop bool) {
+			check(pending.Disbursement)
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "disbursement-references", fmt.Sprintf(
+			"%d disbursements without a recorded reference found\n%s", count, msg)), count != 0
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/DFWallet/anatha/types"
+	sdkerrors "github.com/DFWallet/anatha/types/errors"
 	"github.com/DFWallet/project-anatha/x/treasury/internal/types"
 )
 
@@ -110,4 +111,180 @@ func (k Keeper) GetOperators(ctx sdk.Context) []sdk.AccAddress {
 	})
 
 	return operators
-}
\ No newline at end of file
+}
+
+// HandleSetOperatorPolicy replaces the limits of the operator, an empty policy lifts them
+func (k Keeper) HandleSetOperatorPolicy(ctx sdk.Context, sender sdk.AccAddress, policy types.OperatorPolicy) error {
+	if ! k.IsManager(ctx, sender) {
+		return types.ErrNotManager
+	}
+
+	if ! k.IsOperator(ctx, policy.Operator) {
+		return types.ErrNotOperator
+	}
+
+	if policy.IsEmpty() {
+		k.DeleteOperatorPolicy(ctx, policy.Operator)
+	} else {
+		k.SetOperatorPolicy(ctx, policy)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetOperatorPolicy,
+			sdk.NewAttribute(types.AttributeKeyOperator, policy.Operator.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxPerTransaction, policy.MaxPerTransaction.String()),
+			sdk.NewAttribute(types.AttributeKeyDailyQuota, policy.DailyQuota.String()),
+			sdk.NewAttribute(types.AttributeKeyWeeklyQuota, policy.WeeklyQuota.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		),
+	})
+
+	return nil
+}
+
+// ConsumeOperatorAllowance checks the amount against the operator's policy and records it as spent under the reference,
+// an empty recipient skips the recipient check
+func (k Keeper) ConsumeOperatorAllowance(ctx sdk.Context, operator sdk.AccAddress, recipient sdk.AccAddress, amount sdk.Coins, reference string) error {
+	now := ctx.BlockTime()
+
+	usage, found := k.GetOperatorUsage(ctx, operator)
+	if ! found {
+		usage = types.NewOperatorUsage(operator)
+	}
+
+	usage.Prune(now)
+
+	policy, found := k.GetOperatorPolicy(ctx, operator)
+	if found {
+		if ! recipient.Empty() && ! policy.IsRecipientAllowed(recipient) {
+			return sdkerrors.Wrap(types.ErrRecipientNotAllowed, recipient.String())
+		}
+
+		if types.ExceedsLimit(amount, policy.MaxPerTransaction) {
+			return sdkerrors.Wrapf(types.ErrOperatorLimitExceeded, "max per transaction %s", policy.MaxPerTransaction)
+		}
+
+		if types.ExceedsLimit(usage.Spent(now, types.OperatorDailyWindow).Add(amount...), policy.DailyQuota) {
+			return sdkerrors.Wrapf(types.ErrOperatorLimitExceeded, "daily quota %s", policy.DailyQuota)
+		}
+
+		if types.ExceedsLimit(usage.Spent(now, types.OperatorWeeklyWindow).Add(amount...), policy.WeeklyQuota) {
+			return sdkerrors.Wrapf(types.ErrOperatorLimitExceeded, "weekly quota %s", policy.WeeklyQuota)
+		}
+	}
+
+	usage.Entries = append(usage.Entries, types.OperatorUsageEntry{Time: now, Amount: amount, Reference: reference})
+
+	k.SetOperatorUsage(ctx, usage)
+
+	return nil
+}
+
+// RefundOperatorAllowance gives back the quota consumed by a disbursement that will not be paid out
+func (k Keeper) RefundOperatorAllowance(ctx sdk.Context, operator sdk.AccAddress, reference string) {
+	usage, found := k.GetOperatorUsage(ctx, operator)
+	if ! found {
+		return
+	}
+
+	usage.Refund(reference)
+
+	k.SetOperatorUsage(ctx, usage)
+}
+
+// CheckOperatorRecipient enforces the allowed recipient list for payouts that were already counted against the quotas
+func (k Keeper) CheckOperatorRecipient(ctx sdk.Context, operator sdk.AccAddress, recipient sdk.AccAddress) error {
+	policy, found := k.GetOperatorPolicy(ctx, operator)
+	if found && ! policy.IsRecipientAllowed(recipient) {
+		return sdkerrors.Wrap(types.ErrRecipientNotAllowed, recipient.String())
+	}
+
+	return nil
+}
+
+func (k Keeper) SetOperatorPolicy(ctx sdk.Context, policy types.OperatorPolicy) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetOperatorPolicyKey(policy.Operator), k.cdc.MustMarshalBinaryBare(policy))
+}
+
+func (k Keeper) GetOperatorPolicy(ctx sdk.Context, operator sdk.AccAddress) (types.OperatorPolicy, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var policy types.OperatorPolicy
+
+	bz := store.Get(types.GetOperatorPolicyKey(operator))
+	if bz == nil {
+		return policy, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &policy)
+
+	return policy, true
+}
+
+func (k Keeper) DeleteOperatorPolicy(ctx sdk.Context, operator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetOperatorPolicyKey(operator))
+}
+
+func (k Keeper) GetOperatorPolicies(ctx sdk.Context) []types.OperatorPolicy {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OperatorPolicyKeyPrefix)
+
+	defer iterator.Close()
+
+	policies := make([]types.OperatorPolicy, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.OperatorPolicy
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &policy)
+
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
+
+func (k Keeper) SetOperatorUsage(ctx sdk.Context, usage types.OperatorUsage) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetOperatorUsageKey(usage.Operator), k.cdc.MustMarshalBinaryBare(usage))
+}
+
+func (k Keeper) GetOperatorUsage(ctx sdk.Context, operator sdk.AccAddress) (types.OperatorUsage, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var usage types.OperatorUsage
+
+	bz := store.Get(types.GetOperatorUsageKey(operator))
+	if bz == nil {
+		return usage, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &usage)
+
+	return usage, true
+}
+
+func (k Keeper) GetOperatorUsages(ctx sdk.Context) []types.OperatorUsage {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OperatorUsageKeyPrefix)
+
+	defer iterator.Close()
+
+	usages := make([]types.OperatorUsage, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.OperatorUsage
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &usage)
+
+		usages = append(usages, usage)
+	}
+
+	return usages
+}
@@ -3,35 +3,13 @@ package keeper
 import (
 	sdk "github.com/DFWallet/anatha/types"
 	sdkerrors "github.com/DFWallet/anatha/types/errors"
-	"github.com/DFWallet/project-anatha/config"
 	"github.com/DFWallet/project-anatha/x/treasury/internal/types"
 )
 
-func (k Keeper) HandleCreateSellOrder(ctx sdk.Context, sender sdk.AccAddress, pinAmount sdk.Coins) error {
-	buyBackPercentage := k.BuyBackPercentage(ctx)
-	stagePrice := k.GetPriceForStage(
-		ctx,
-		k.GetStageFromDistribution(
-			ctx,
-			k.DistributedFromTreasury(ctx),
-		),
-	)
-
-	dinAmountInt := pinAmount.AmountOf(config.DefaultDenom).Mul(stagePrice)
-	dinAmountInt = dinAmountInt.ToDec().Mul(buyBackPercentage).TruncateInt()
-
-	dinAmount := sdk.NewCoins(sdk.NewCoin(config.DefaultStableDenom, dinAmountInt))
-
-	if ! dinAmount.IsZero() {
-		err := k.TransferToBuyBackFund(ctx, sender, pinAmount)
-		if err != nil {
-			return err
-		}
-
-		err = k.TransferFromBuyBackFund(ctx, sender, dinAmount)
-		if err != nil {
-			return err
-		}
+func (k Keeper) HandleCreateSellOrder(ctx sdk.Context, sender sdk.AccAddress, pinAmount sdk.Coins, minReceived sdk.Coins) error {
+	dinAmount, err := k.executeSellOrder(ctx, sender, pinAmount, minReceived, false)
+	if err != nil {
+		return err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -50,19 +28,12 @@ func (k Keeper) HandleCreateSellOrder(ctx sdk.Context, sender sdk.AccAddress, pi
 	return nil
 }
 
-func (k Keeper) HandleCreateBuyOrder(ctx sdk.Context, buyer sdk.AccAddress, dinAmount sdk.Coins) error {
+func (k Keeper) HandleCreateBuyOrder(ctx sdk.Context, buyer sdk.AccAddress, dinAmount sdk.Coins, minReceived sdk.Coins) error {
 	if ! k.BankKeeper.HasCoins(ctx, buyer, dinAmount) {
 		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "Insufficient funds for ANATHA purchase.")
 	}
 
-	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, buyer, types.DistributionProfitsModuleName, dinAmount)
-	if err != nil {
-		return err
-	}
-
-	_, fromBuyBack, fromTreasury := k.CalculatePinAmountExtended(ctx, dinAmount)
-
-	err = k.DisburseFunds(ctx, nil, buyer, dinAmount, fromBuyBack, fromTreasury)
+	pinAmount, err := k.executeBuyOrder(ctx, buyer, dinAmount, minReceived, false)
 	if err != nil {
 		return err
 	}
@@ -70,7 +41,7 @@ func (k Keeper) HandleCreateBuyOrder(ctx sdk.Context, buyer sdk.AccAddress, dinA
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateBuyOrder,
-			sdk.NewAttribute(types.AttributeKeyPinAmount, fromBuyBack.Add(fromTreasury...).String()),
+			sdk.NewAttribute(types.AttributeKeyPinAmount, pinAmount.String()),
 			sdk.NewAttribute(types.AttributeKeyDinAmount, dinAmount.String()),
 		),
 		sdk.NewEvent(
@@ -82,3 +53,74 @@ func (k Keeper) HandleCreateBuyOrder(ctx sdk.Context, buyer sdk.AccAddress, dinA
 
 	return nil
 }
+
+// SellPrice returns the din paid per pin by the buyback, the stage price reduced to the buyback percentage
+func (k Keeper) SellPrice(ctx sdk.Context) sdk.Dec {
+	return k.CurrentStagePrice(ctx).ToDec().Mul(k.BuyBackPercentage(ctx))
+}
+
+func (k Keeper) CurrentStagePrice(ctx sdk.Context) sdk.Int {
+	return k.GetPriceForStage(
+		ctx,
+		k.GetStageFromDistribution(
+			ctx,
+			k.DistributedFromTreasury(ctx),
+		),
+	)
+}
+
+// executeSellOrder swaps pin for din at the current stage price, escrowed pin is taken from the order book instead of the seller
+func (k Keeper) executeSellOrder(ctx sdk.Context, seller sdk.AccAddress, pinAmount sdk.Coins, minReceived sdk.Coins, escrowed bool) (sdk.Coins, error) {
+	dinAmount := k.CalculateSellAmount(ctx, pinAmount)
+
+	if ! minReceived.Empty() && ! dinAmount.IsAllGTE(minReceived) {
+		return nil, sdkerrors.Wrapf(types.ErrSlippageExceeded, "%s < %s", dinAmount, minReceived)
+	}
+
+	if dinAmount.IsZero() {
+		return dinAmount, nil
+	}
+
+	var err error
+	if escrowed {
+		err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.OrderBookModuleName, types.BuyBackFundModuleName, pinAmount)
+	} else {
+		err = k.TransferToBuyBackFund(ctx, seller, pinAmount)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.TransferFromBuyBackFund(ctx, seller, dinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return dinAmount, nil
+}
+
+// executeBuyOrder sells pin for din from the buyback fund and the treasury, escrowed din is taken from the order book instead of the buyer
+func (k Keeper) executeBuyOrder(ctx sdk.Context, buyer sdk.AccAddress, dinAmount sdk.Coins, minReceived sdk.Coins, escrowed bool) (sdk.Coins, error) {
+	pinAmount, fromBuyBack, fromTreasury := k.CalculatePinAmountExtended(ctx, dinAmount)
+
+	if ! minReceived.Empty() && ! pinAmount.IsAllGTE(minReceived) {
+		return nil, sdkerrors.Wrapf(types.ErrSlippageExceeded, "%s < %s", pinAmount, minReceived)
+	}
+
+	var err error
+	if escrowed {
+		err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.OrderBookModuleName, types.DistributionProfitsModuleName, dinAmount)
+	} else {
+		err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, buyer, types.DistributionProfitsModuleName, dinAmount)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.DisburseFunds(ctx, nil, buyer, dinAmount, fromBuyBack, fromTreasury)
+	if err != nil {
+		return nil, err
+	}
+
+	return pinAmount, nil
+}
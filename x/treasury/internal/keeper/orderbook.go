@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/x/treasury/internal/types"
+)
+
+// HandleCreateLimitOrder escrows the amount and rests the order in the book until the EndBlocker fills it
+func (k Keeper) HandleCreateLimitOrder(ctx sdk.Context, owner sdk.AccAddress, side string, amount sdk.Coins, price sdk.Int) error {
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.OrderBookModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	order := types.NewLimitOrder(k.GetNextLimitOrderId(ctx), owner, side, amount, price, ctx.BlockTime())
+
+	k.SetLimitOrder(ctx, order)
+	k.SetNextLimitOrderId(ctx, order.Id + 1)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySide, side),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+func (k Keeper) HandleCancelLimitOrder(ctx sdk.Context, owner sdk.AccAddress, id uint64) error {
+	order, found := k.GetLimitOrder(ctx, id)
+	if ! found {
+		return types.ErrLimitOrderNotFound
+	}
+
+	if ! owner.Equals(order.Owner) {
+		return types.ErrNotOrderOwner
+	}
+
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.OrderBookModuleName, owner, order.Amount)
+	if err != nil {
+		return err
+	}
+
+	k.DeleteLimitOrder(ctx, order)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, order.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueModule),
+			sdk.NewAttribute(types.AttributeKeySender, owner.String()),
+		),
+	})
+
+	return nil
+}
+
+// MatchLimitOrders fills the resting orders crossed by the current prices, best priced and oldest first.
+// Every fill moves the stage price, so each order is checked again right before it is filled.
+// At most MaxLimitOrderFillsPerBlock fills are attempted per side, the next block continues after the last attempted order.
+func (k Keeper) MatchLimitOrders(ctx sdk.Context) {
+	for _, side := range []string{types.OrderSideBuy, types.OrderSideSell} {
+		orders, reachedEnd := k.getCrossedLimitOrders(ctx, side)
+
+		for _, order := range orders {
+			if ! order.IsCrossed(k.CurrentStagePrice(ctx), k.SellPrice(ctx)) {
+				continue
+			}
+
+			err := k.fillLimitOrder(ctx, order)
+			if err != nil {
+				k.Logger(ctx).Info(fmt.Sprintf("limit order %d not filled: %s", order.Id, err.Error()))
+			}
+		}
+
+		store := ctx.KVStore(k.storeKey)
+		if reachedEnd {
+			store.Delete(types.GetLimitOrderMatchCursorKey(side))
+		} else {
+			store.Set(types.GetLimitOrderMatchCursorKey(side), types.GetLimitOrderBookKey(orders[len(orders) - 1]))
+		}
+	}
+}
+
+// fillLimitOrder executes the order in a cached context, a failed fill leaves the order resting.
+// A fill spilling into stages priced above the limit receives less than MinReceived and fails.
+func (k Keeper) fillLimitOrder(ctx sdk.Context, order types.LimitOrder) error {
+	cacheCtx, write := ctx.CacheContext()
+
+	var received sdk.Coins
+	var err error
+
+	receivedKey := types.AttributeKeyPinAmount
+	if order.Side == types.OrderSideBuy {
+		received, err = k.executeBuyOrder(cacheCtx, order.Owner, order.Amount, order.MinReceived(), true)
+	} else {
+		receivedKey = types.AttributeKeyDinAmount
+		received, err = k.executeSellOrder(cacheCtx, order.Owner, order.Amount, order.MinReceived(), true)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	k.DeleteLimitOrder(ctx, order)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFillLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, order.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeySide, order.Side),
+			sdk.NewAttribute(types.AttributeKeyAmount, order.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, order.Price.String()),
+			sdk.NewAttribute(receivedKey, received.String()),
+		),
+	)
+
+	return nil
+}
+
+// getCrossedLimitOrders walks the book from the cursor, or the best price, and stops at the first order the current price does not cross
+func (k Keeper) getCrossedLimitOrders(ctx sdk.Context, side string) (orders []types.LimitOrder, reachedEnd bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetLimitOrderBookIteratorKey(side)
+
+	start := prefix
+	cursor := store.Get(types.GetLimitOrderMatchCursorKey(side))
+	if cursor != nil {
+		start = append(cursor, 0x00)
+	}
+
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(prefix))
+
+	defer iterator.Close()
+
+	buyPrice := k.CurrentStagePrice(ctx)
+	sellPrice := k.SellPrice(ctx)
+
+	for ; iterator.Valid() && len(orders) < types.MaxLimitOrderFillsPerBlock; iterator.Next() {
+		order, found := k.GetLimitOrder(ctx, types.GetUint64FromBytes(iterator.Value()))
+		if ! found {
+			continue
+		}
+
+		if ! order.IsCrossed(buyPrice, sellPrice) {
+			return orders, true
+		}
+
+		orders = append(orders, order)
+	}
+
+	return orders, ! iterator.Valid()
+}
+
+func (k Keeper) SetLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetLimitOrderKey(order.Id), k.cdc.MustMarshalBinaryBare(order))
+	store.Set(types.GetLimitOrderBookKey(order), types.GetUint64Bytes(order.Id))
+	store.Set(types.GetLimitOrderByOwnerKey(order.Owner, order.Id), types.StatusPresent)
+}
+
+func (k Keeper) GetLimitOrder(ctx sdk.Context, id uint64) (types.LimitOrder, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var order types.LimitOrder
+
+	bz := store.Get(types.GetLimitOrderKey(id))
+	if bz == nil {
+		return order, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &order)
+
+	return order, true
+}
+
+func (k Keeper) DeleteLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetLimitOrderKey(order.Id))
+	store.Delete(types.GetLimitOrderBookKey(order))
+	store.Delete(types.GetLimitOrderByOwnerKey(order.Owner, order.Id))
+}
+
+func (k Keeper) GetLimitOrdersByOwner(ctx sdk.Context, owner sdk.AccAddress) []types.LimitOrder {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetLimitOrderByOwnerIteratorKey(owner)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+
+	orders := make([]types.LimitOrder, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		order, found := k.GetLimitOrder(ctx, types.GetUint64FromBytes(iterator.Key()[len(prefix):]))
+		if found {
+			orders = append(orders, order)
+		}
+	}
+
+	return orders
+}
+
+func (k Keeper) GetLimitOrders(ctx sdk.Context) []types.LimitOrder {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.LimitOrderKeyPrefix)
+
+	defer iterator.Close()
+
+	orders := make([]types.LimitOrder, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.LimitOrder
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &order)
+
+		orders = append(orders, order)
+	}
+
+	return orders
+}
+
+func (k Keeper) GetNextLimitOrderId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NextLimitOrderIdKey)
+	if bz == nil {
+		return 1
+	}
+
+	return types.GetUint64FromBytes(bz)
+}
+
+func (k Keeper) SetNextLimitOrderId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.NextLimitOrderIdKey, types.GetUint64Bytes(id))
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DFWallet/anatha/codec"
+	"github.com/DFWallet/anatha/store"
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/anatha/x/auth"
+	"github.com/DFWallet/anatha/x/bank"
+	"github.com/DFWallet/anatha/x/params"
+	"github.com/DFWallet/anatha/x/supply"
+	"github.com/DFWallet/project-anatha/config"
+	"github.com/DFWallet/project-anatha/x/treasury/internal/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// stage 0 holds 1000pin at 10000din, stage 1 at 20000din
+func createOrderBookTestInput(t *testing.T) (sdk.Context, Keeper, bank.Keeper) {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyTreasury := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Now()}, false, log.NewNopLogger())
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), map[string]bool{})
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, map[string][]string{
+		types.ModuleName:                    {supply.Minter},
+		types.BuyBackFundModuleName:         nil,
+		types.DistributionProfitsModuleName: {supply.Burner},
+		types.OrderBookModuleName:           nil,
+	})
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+
+	k := NewKeeper(cdc, keyTreasury, paramsKeeper.Subspace(types.DefaultParamspace), supplyKeeper, accountKeeper, bankKeeper)
+	k.SetParams(ctx, types.DefaultParams())
+	k.SetTreasury(ctx, types.NewTreasury(
+		false,
+		sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, 1000000)),
+		sdk.NewCoins(),
+		sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, 1000)),
+		sdk.NewCoins(),
+	))
+	k.SetPriceCurve(ctx, types.NewScheduledPriceCurve(0, types.NewLinearPriceCurveParams(sdk.NewInt(10000), sdk.NewInt(10000))))
+
+	require.NoError(t, k.MintCoins(ctx, sdk.NewCoins(sdk.NewInt64Coin(config.DefaultDenom, 1000000))))
+
+	return ctx, k, bankKeeper
+}
+
+func createLimitOrderOwner(t *testing.T, ctx sdk.Context, bankKeeper bank.Keeper, amount sdk.Coins) sdk.AccAddress {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	_, err := bankKeeper.AddCoins(ctx, owner, amount)
+	require.NoError(t, err)
+
+	return owner
+}
+
+func TestMatchLimitOrdersAcrossStages(t *testing.T) {
+	// 15000000din buys all 1000pin of stage 0 and 250pin of stage 1, 1250pin in total
+	amount := sdk.NewCoins(sdk.NewInt64Coin(config.DefaultStableDenom, 15000000))
+
+	t.Run("fill above the limit price stays resting", func(t *testing.T) {
+		ctx, k, bankKeeper := createOrderBookTestInput(t)
+		owner := createLimitOrderOwner(t, ctx, bankKeeper, amount)
+
+		// at most 10000din per pin, at least 1500pin
+		require.NoError(t, k.HandleCreateLimitOrder(ctx, owner, types.OrderSideBuy, amount, sdk.NewInt(10000)))
+
+		k.MatchLimitOrders(ctx)
+
+		_, found := k.GetLimitOrder(ctx, 1)
+		require.True(t, found)
+		require.True(t, bankKeeper.GetCoins(ctx, owner).Empty())
+		require.True(t, k.DistributedFromTreasury(ctx).IsZero())
+	})
+
+	t.Run("fill within the limit price", func(t *testing.T) {
+		ctx, k, bankKeeper := createOrderBookTestInput(t)
+		owner := createLimitOrderOwner(t, ctx, bankKeeper, amount)
+
+		// at most 20000din per pin, at least 750pin
+		require.NoError(t, k.HandleCreateLimitOrder(ctx, owner, types.OrderSideBuy, amount, sdk.NewInt(20000)))
+
+		k.MatchLimitOrders(ctx)
+
+		_, found := k.GetLimitOrder(ctx, 1)
+		require.False(t, found)
+		require.Equal(t, sdk.NewInt(1250), bankKeeper.GetCoins(ctx, owner).AmountOf(config.DefaultDenom))
+		require.Equal(t, sdk.NewInt(1250), k.DistributedFromTreasury(ctx))
+		require.Equal(t, sdk.NewInt(20000), k.CurrentStagePrice(ctx))
+	})
+}
@@ -26,6 +26,31 @@ func (k Keeper) BuyBackPercentage(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+func (k Keeper) DisbursementRetryBackoff(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDisbursementRetryBackoff, &res)
+	return
+}
+
+func (k Keeper) DisbursementMaxRetries(ctx sdk.Context) (res uint16) {
+	k.paramspace.Get(ctx, types.KeyDisbursementMaxRetries, &res)
+	return
+}
+
+func (k Keeper) ApprovalTiers(ctx sdk.Context) (res []types.ApprovalTier) {
+	k.paramspace.Get(ctx, types.KeyApprovalTiers, &res)
+	return
+}
+
+func (k Keeper) SnapshotInterval(ctx sdk.Context) (res int64) {
+	k.paramspace.Get(ctx, types.KeySnapshotInterval, &res)
+	return
+}
+
+func (k Keeper) SnapshotRetention(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeySnapshotRetention, &res)
+	return
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
 	return params
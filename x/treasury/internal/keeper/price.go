@@ -149,11 +149,18 @@ func (k Keeper) CalculatePinAmountExtended(ctx sdk.Context, dinCoins sdk.Coins)
 }
 
 func (k Keeper) CalculatePinAmount(ctx sdk.Context, dinCoins sdk.Coins) sdk.Coins {
-	dinAmount := dinCoins.AmountOf(config.DefaultStableDenom)
+	pinAmount, _ := k.calculatePinAmountByStage(ctx, dinCoins.AmountOf(config.DefaultStableDenom))
+
+	return sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, pinAmount))
+}
+
+// calculatePinAmountByStage walks the treasury stages for the given din and returns the pin bought within each of them
+func (k Keeper) calculatePinAmountByStage(ctx sdk.Context, dinAmount sdk.Int) (sdk.Int, []types.QuoteStage) {
 	pinAmount := sdk.ZeroInt()
+	var stages []types.QuoteStage
 
 	if dinAmount.LTE(sdk.ZeroInt()) {
-		return sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, pinAmount))
+		return pinAmount, stages
 	}
 
 	stage := k.GetStageFromDistribution(ctx, k.DistributedFromTreasury(ctx))
@@ -167,27 +174,48 @@ func (k Keeper) CalculatePinAmount(ctx sdk.Context, dinCoins sdk.Coins) sdk.Coin
 
 		remainingDinPrice := remainingPin.Mul(stagePrice)
 
+		var stagePin, stageDin sdk.Int
+
 		if remainingDinPrice.LT(dinAmount) {
 			// we are clearing out a stage and transitioning to the next
 
-			pinAmount = pinAmount.Add(remainingPin)
-			dinAmount = dinAmount.Sub(remainingDinPrice)
+			stagePin = remainingPin
+			stageDin = remainingDinPrice
 		} else {
 			// we have enough liquidity in the current stage
 
-			pinAmount = pinAmount.Add(dinAmount.Quo(stagePrice))
-			dinAmount = sdk.ZeroInt()
+			stagePin = dinAmount.Quo(stagePrice)
+			stageDin = dinAmount
 		}
 
+		pinAmount = pinAmount.Add(stagePin)
+		dinAmount = dinAmount.Sub(stageDin)
+
+		stages = append(stages, types.QuoteStage{
+			Source: types.QuoteSourceTreasury,
+			Stage: stage,
+			Price: stagePrice,
+			PinAmount: stagePin,
+			DinAmount: stageDin,
+		})
+
 		stage = stage.Add(sdk.OneInt())
 	}
 
-	return sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, pinAmount))
+	return pinAmount, stages
+}
+
+// CalculateSellAmount returns the din the buyback pays for the given pin, the stage price reduced by the buyback percentage
+func (k Keeper) CalculateSellAmount(ctx sdk.Context, pinCoins sdk.Coins) sdk.Coins {
+	dinAmount := pinCoins.AmountOf(config.DefaultDenom).Mul(k.CurrentStagePrice(ctx))
+	dinAmount = dinAmount.ToDec().Mul(k.BuyBackPercentage(ctx)).TruncateInt()
+
+	return sdk.NewCoins(sdk.NewCoin(config.DefaultStableDenom, dinAmount))
 }
 
 // returned amount is $0.0000000001 per PIN
 func (k Keeper) GetPriceForStage(ctx sdk.Context, stage sdk.Int) sdk.Int {
-	return stage.Add(sdk.OneInt())
+	return k.GetPriceCurveForStage(ctx, stage).PriceForStage(stage)
 }
 
 func (k Keeper) GetStageFromDistribution(ctx sdk.Context, distribution sdk.Int) sdk.Int {
@@ -19,6 +19,14 @@ const (
 	QueryDisbursements = "disbursements"
 	QueryPrice = "price"
 	QueryDisbursementEscrow = "disbursement-escrow"
+	QueryFailedDisbursements = "failed-disbursements"
+	QueryDisbursementRecord = "disbursement-record"
+	QueryPendingDisbursements = "pending-disbursements"
+	QueryOperator = "operator"
+	QueryLimitOrders = "limit-orders"
+	QueryQuote = "quote"
+	QueryPriceCurve = "price-curve"
+	QuerySnapshots = "snapshots"
 )
 
 // NewQuerier creates a new querier for treasury clients.
@@ -37,6 +45,22 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryPrice(ctx, path[1:], req, k)
 		case QueryDisbursementEscrow:
 			return queryDisbursementEscrow(ctx, path[1:], req, k)
+		case QueryFailedDisbursements:
+			return queryFailedDisbursements(ctx, k)
+		case QueryDisbursementRecord:
+			return queryDisbursementRecord(ctx, path[1:], req, k)
+		case QueryPendingDisbursements:
+			return queryPendingDisbursements(ctx, k)
+		case QueryOperator:
+			return queryOperator(ctx, path[1:], req, k)
+		case QueryLimitOrders:
+			return queryLimitOrders(ctx, path[1:], req, k)
+		case QueryQuote:
+			return queryQuote(ctx, path[1:], req, k)
+		case QueryPriceCurve:
+			return queryPriceCurve(ctx, k)
+		case QuerySnapshots:
+			return querySnapshots(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown treasury query endpoint")
 		}
@@ -77,6 +101,51 @@ func queryOperators(ctx sdk.Context, k Keeper) ([]byte, error) {
 	return res, nil
 }
 
+func queryOperator(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	operator, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, path[0])
+	}
+
+	policy, found := k.GetOperatorPolicy(ctx, operator)
+	if ! found {
+		policy = types.NewOperatorPolicy(operator, sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins(), []sdk.AccAddress{})
+	}
+
+	usage, _ := k.GetOperatorUsage(ctx, operator)
+
+	result := types.QueryResOperator{
+		Operator: operator,
+		Active: k.IsOperator(ctx, operator),
+		Policy: policy,
+		DailyUsage: usage.Spent(ctx.BlockTime(), types.OperatorDailyWindow),
+		WeeklyUsage: usage.Spent(ctx.BlockTime(), types.OperatorWeeklyWindow),
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, result)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryLimitOrders(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	owner, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, path[0])
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetLimitOrdersByOwner(ctx, owner))
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryDisbursements(ctx sdk.Context, k Keeper) ([]byte, error) {
 	disbursements := k.GetDisbursements(ctx)
 
@@ -89,6 +158,46 @@ func queryDisbursements(ctx sdk.Context, k Keeper) ([]byte, error) {
 	return res, nil
 }
 
+func queryFailedDisbursements(ctx sdk.Context, k Keeper) ([]byte, error) {
+	failedDisbursements := k.GetFailedDisbursements(ctx)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, failedDisbursements)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryDisbursementRecord(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	record, found := k.GetDisbursementRecord(ctx, path[0])
+
+	if ! found {
+		return nil, types.ErrInvalidReference
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, record)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryPendingDisbursements(ctx sdk.Context, k Keeper) ([]byte, error) {
+	pendingDisbursements := k.GetPendingDisbursements(ctx)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, pendingDisbursements)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryPrice(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	coins, err := utils.ParseAndConvertCoins(path[0])
 	if err != nil {
@@ -118,6 +227,78 @@ func queryPrice(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper)
 	return res, nil
 }
 
+func queryQuote(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	coins, err := utils.ParseAndConvertCoins(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var result types.Quote
+
+	if coins.AmountOf(config.DefaultStableDenom).IsPositive() {
+
+		result = k.QuoteBuyOrder(ctx, coins)
+
+	} else if coins.AmountOf(config.DefaultDenom).IsPositive() {
+
+		result = k.QuoteSellOrder(ctx, coins)
+
+	} else {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid denomination. Expected usd or anatha.")
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, result)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryPriceCurve(ctx sdk.Context, k Keeper) ([]byte, error) {
+	stage := k.GetStageFromDistribution(ctx, k.DistributedFromTreasury(ctx))
+
+	var scheduled []types.ScheduledPriceCurve
+	for _, curve := range k.GetPriceCurves(ctx) {
+		if sdk.NewIntFromUint64(curve.FromStage).GT(stage) {
+			scheduled = append(scheduled, curve)
+		}
+	}
+
+	result := types.QueryResPriceCurve{
+		Stage: stage,
+		Price: k.GetPriceForStage(ctx, stage),
+		Active: k.GetPriceCurveForStage(ctx, stage),
+		Scheduled: scheduled,
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, result)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func querySnapshots(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySnapshotsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetSnapshotsInRange(ctx, params))
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryDisbursementEscrow(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	reference := strings.ToLower(path[0])
 
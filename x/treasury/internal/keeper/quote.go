@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/config"
+	"github.com/DFWallet/project-anatha/x/treasury/internal/types"
+)
+
+// QuoteBuyOrder simulates buying pin with the given din, first from the buyback fund and then across the treasury stages
+func (k Keeper) QuoteBuyOrder(ctx sdk.Context, dinCoins sdk.Coins) types.Quote {
+	startStage := k.GetStageFromDistribution(ctx, k.DistributedFromTreasury(ctx))
+	stagePrice := k.GetPriceForStage(ctx, startStage)
+
+	total, fromBuyBack, fromTreasury := k.CalculatePinAmountExtended(ctx, dinCoins)
+
+	var stages []types.QuoteStage
+
+	buyBackPin := fromBuyBack.AmountOf(config.DefaultDenom)
+	if buyBackPin.IsPositive() {
+		stages = append(stages, types.QuoteStage{
+			Source: types.QuoteSourceBuyBack,
+			Stage: startStage,
+			Price: stagePrice,
+			PinAmount: buyBackPin,
+			DinAmount: buyBackPin.Mul(stagePrice),
+		})
+	}
+
+	treasuryPin := fromTreasury.AmountOf(config.DefaultDenom)
+	if treasuryPin.IsPositive() {
+		_, treasuryStages := k.calculatePinAmountByStage(ctx, dinCoins.AmountOf(config.DefaultStableDenom).Sub(buyBackPin.Mul(stagePrice)))
+		stages = append(stages, treasuryStages...)
+	}
+
+	return types.Quote{
+		Side: types.OrderSideBuy,
+		Input: dinCoins,
+		Output: total,
+		FromBuyBack: fromBuyBack,
+		FromTreasury: fromTreasury,
+		BuyBackPercentage: k.BuyBackPercentage(ctx),
+		Haircut: sdk.NewCoins(),
+		StartStage: startStage,
+		EndStage: k.GetStageFromDistribution(ctx, k.DistributedFromTreasury(ctx).Add(treasuryPin)),
+		Stages: stages,
+		AveragePrice: averagePrice(dinCoins.AmountOf(config.DefaultStableDenom), total.AmountOf(config.DefaultDenom)),
+	}
+}
+
+// QuoteSellOrder simulates selling pin to the buyback fund, sells never move the stage
+func (k Keeper) QuoteSellOrder(ctx sdk.Context, pinCoins sdk.Coins) types.Quote {
+	stage := k.GetStageFromDistribution(ctx, k.DistributedFromTreasury(ctx))
+	stagePrice := k.GetPriceForStage(ctx, stage)
+
+	pinAmount := pinCoins.AmountOf(config.DefaultDenom)
+	output := k.CalculateSellAmount(ctx, pinCoins)
+	dinAmount := output.AmountOf(config.DefaultStableDenom)
+
+	return types.Quote{
+		Side: types.OrderSideSell,
+		Input: pinCoins,
+		Output: output,
+		FromBuyBack: output,
+		FromTreasury: sdk.NewCoins(),
+		BuyBackPercentage: k.BuyBackPercentage(ctx),
+		Haircut: sdk.NewCoins(sdk.NewCoin(config.DefaultStableDenom, pinAmount.Mul(stagePrice).Sub(dinAmount))),
+		StartStage: stage,
+		EndStage: stage,
+		Stages: []types.QuoteStage{
+			{
+				Source: types.QuoteSourceBuyBack,
+				Stage: stage,
+				Price: stagePrice,
+				PinAmount: pinAmount,
+				DinAmount: dinAmount,
+			},
+		},
+		AveragePrice: averagePrice(dinAmount, pinAmount),
+	}
+}
+
+func averagePrice(dinAmount sdk.Int, pinAmount sdk.Int) sdk.Dec {
+	if ! pinAmount.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return dinAmount.ToDec().QuoInt(pinAmount)
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/x/treasury/internal/types"
+	"strings"
+)
+
+// OpenDisbursementRecord starts the audit trail of a new reference, a re-issued reference keeps the history of its cancelled use
+func (k Keeper) OpenDisbursementRecord(ctx sdk.Context, reference string, operator sdk.AccAddress, recipient sdk.AccAddress, amount sdk.Coins, status string, entryAmount sdk.Coins, note string) {
+	record, found := k.GetDisbursementRecord(ctx, reference)
+	if found {
+		record.Operator = operator
+		record.Recipient = recipient
+		record.Amount = amount
+	} else {
+		record = types.NewDisbursementRecord(reference, operator, recipient, amount, ctx.BlockHeight())
+	}
+
+	record.AddEntry(status, entryAmount, ctx.BlockHeight(), ctx.BlockTime(), note)
+
+	k.SetDisbursementRecord(ctx, record)
+}
+
+// UpdateDisbursementRecord appends a state change to the audit trail of the reference, references created before the trail existed get a fresh record
+func (k Keeper) UpdateDisbursementRecord(ctx sdk.Context, reference string, status string, amount sdk.Coins, note string) {
+	record, found := k.GetDisbursementRecord(ctx, reference)
+	if ! found {
+		record = types.NewDisbursementRecord(reference, sdk.AccAddress{}, sdk.AccAddress{}, sdk.NewCoins(), ctx.BlockHeight())
+	}
+
+	record.AddEntry(status, amount, ctx.BlockHeight(), ctx.BlockTime(), note)
+
+	k.SetDisbursementRecord(ctx, record)
+}
+
+func (k Keeper) SetDisbursementRecord(ctx sdk.Context, record types.DisbursementRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetDisbursementRecordKey(strings.ToLower(record.Reference)), k.cdc.MustMarshalBinaryBare(record))
+}
+
+func (k Keeper) GetDisbursementRecord(ctx sdk.Context, reference string) (types.DisbursementRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var record types.DisbursementRecord
+
+	bz := store.Get(types.GetDisbursementRecordKey(strings.ToLower(reference)))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+
+	return record, true
+}
+
+func (k Keeper) IterateDisbursementRecords(ctx sdk.Context, cb func(record types.DisbursementRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DisbursementRecordKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.DisbursementRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetDisbursementRecords(ctx sdk.Context) []types.DisbursementRecord {
+	records := make([]types.DisbursementRecord, 0)
+	k.IterateDisbursementRecords(ctx, func(record types.DisbursementRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
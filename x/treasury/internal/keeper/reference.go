@@ -17,6 +17,8 @@ func (k Keeper) SetDisbursementReferenceAmount(ctx sdk.Context, reference string
 func (k Keeper) RemoveDisbursementReferenceAmount(ctx sdk.Context, reference string) {
 	store := ctx.KVStore(k.storeKey)
 
+	reference = strings.ToLower(reference)
+
 	store.Delete(types.GetDisbursementReferenceKey(reference))
 }
 
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/x/treasury/internal/types"
+)
+
+// TrackSnapshots records a snapshot every SnapshotInterval blocks and prunes the ones past the retention period
+func (k Keeper) TrackSnapshots(ctx sdk.Context) {
+	interval := k.SnapshotInterval(ctx)
+	if interval <= 0 || ctx.BlockHeight() % interval != 0 {
+		return
+	}
+
+	k.SetSnapshot(ctx, k.TakeSnapshot(ctx))
+	k.PruneSnapshots(ctx)
+}
+
+func (k Keeper) TakeSnapshot(ctx sdk.Context) types.TreasurySnapshot {
+	treasury := k.GetTreasury(ctx)
+	stage := k.GetStageFromDistribution(ctx, k.DistributedFromTreasury(ctx))
+
+	return types.TreasurySnapshot{
+		Height: ctx.BlockHeight(),
+		Time: ctx.BlockTime(),
+		Distributed: treasury.Distributed,
+		InboundDin: treasury.InboundDin,
+		Stage: stage,
+		StagePrice: k.GetPriceForStage(ctx, stage),
+		BuyBackFund: k.supplyKeeper.GetModuleAccount(ctx, types.BuyBackFundModuleName).GetCoins(),
+		BuyBackLiquidity: k.supplyKeeper.GetModuleAccount(ctx, types.BuyBackLiquidityFundModuleName).GetCoins(),
+		TreasuryEscrow: k.supplyKeeper.GetModuleAccount(ctx, types.TreasuryEscrowModuleName).GetCoins(),
+		SwapEscrow: k.supplyKeeper.GetModuleAccount(ctx, types.SwapEscrowModuleName).GetCoins(),
+	}
+}
+
+// PruneSnapshots deletes the snapshots taken before the retention period
+func (k Keeper) PruneSnapshots(ctx sdk.Context) {
+	cutoff := ctx.BlockTime().Add(-k.SnapshotRetention(ctx))
+
+	var expired []int64
+	k.IterateSnapshots(ctx, func(snapshot types.TreasurySnapshot) (stop bool) {
+		if ! snapshot.Time.Before(cutoff) {
+			return true
+		}
+
+		expired = append(expired, snapshot.Height)
+		return false
+	})
+
+	for _, height := range expired {
+		k.DeleteSnapshot(ctx, height)
+	}
+}
+
+func (k Keeper) SetSnapshot(ctx sdk.Context, snapshot types.TreasurySnapshot) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetSnapshotKey(snapshot.Height), k.cdc.MustMarshalBinaryBare(snapshot))
+}
+
+func (k Keeper) GetSnapshot(ctx sdk.Context, height int64) (types.TreasurySnapshot, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var snapshot types.TreasurySnapshot
+
+	bz := store.Get(types.GetSnapshotKey(height))
+	if bz == nil {
+		return snapshot, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &snapshot)
+
+	return snapshot, true
+}
+
+func (k Keeper) DeleteSnapshot(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetSnapshotKey(height))
+}
+
+// IterateSnapshots iterates over the snapshots in increasing height order
+func (k Keeper) IterateSnapshots(ctx sdk.Context, cb func(snapshot types.TreasurySnapshot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SnapshotKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.TreasurySnapshot
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &snapshot)
+
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// GetSnapshotsInRange returns the snapshots within the height and time bounds of the params, up to the given limit
+func (k Keeper) GetSnapshotsInRange(ctx sdk.Context, params types.QuerySnapshotsParams) []types.TreasurySnapshot {
+	store := ctx.KVStore(k.storeKey)
+
+	start := types.SnapshotKeyPrefix
+	if params.FromHeight > 0 {
+		start = types.GetSnapshotKey(params.FromHeight)
+	}
+
+	end := sdk.PrefixEndBytes(types.SnapshotKeyPrefix)
+	if params.ToHeight > 0 {
+		end = sdk.PrefixEndBytes(types.GetSnapshotKey(params.ToHeight))
+	}
+
+	limit := params.Limit
+	if limit <= 0 || limit > types.MaxSnapshotQueryResults {
+		limit = types.MaxSnapshotQueryResults
+	}
+
+	iterator := store.Iterator(start, end)
+
+	defer iterator.Close()
+
+	snapshots := make([]types.TreasurySnapshot, 0)
+	for ; iterator.Valid() && len(snapshots) < limit; iterator.Next() {
+		var snapshot types.TreasurySnapshot
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &snapshot)
+
+		if ! params.FromTime.IsZero() && snapshot.Time.Before(params.FromTime) {
+			continue
+		}
+
+		// snapshots are ordered by height and therefore by time
+		if ! params.ToTime.IsZero() && snapshot.Time.After(params.ToTime) {
+			break
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots
+}
+
+func (k Keeper) GetSnapshots(ctx sdk.Context) []types.TreasurySnapshot {
+	snapshots := make([]types.TreasurySnapshot, 0)
+	k.IterateSnapshots(ctx, func(snapshot types.TreasurySnapshot) (stop bool) {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+
+	return snapshots
+}
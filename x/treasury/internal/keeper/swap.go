@@ -14,7 +14,12 @@ func (k Keeper) HandleSwap(ctx sdk.Context, operator sdk.AccAddress, recipient s
 		return types.ErrDuplicateReference
 	}
 
-	err := k.TransferFromSwapEscrow(ctx, recipient, amount)
+	err := k.ConsumeOperatorAllowance(ctx, operator, recipient, amount, reference)
+	if err != nil {
+		return err
+	}
+
+	err = k.TransferFromSwapEscrow(ctx, recipient, amount)
 
 	if err != nil {
 		return err
@@ -22,6 +27,8 @@ func (k Keeper) HandleSwap(ctx sdk.Context, operator sdk.AccAddress, recipient s
 
 	k.SetDisbursementReferenceAmount(ctx, reference, sdk.ZeroInt())
 
+	k.OpenDisbursementRecord(ctx, reference, operator, recipient, amount, types.DisbursementStatusSwapped, amount, "")
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeSwap,
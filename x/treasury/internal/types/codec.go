@@ -8,13 +8,22 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgAddOperator{}, "treasury/AddOperator", nil)
 	cdc.RegisterConcrete(MsgRemoveOperator{}, "treasury/RemoveOperator", nil)
+	cdc.RegisterConcrete(MsgSetOperatorPolicy{}, "treasury/SetOperatorPolicy", nil)
 	cdc.RegisterConcrete(MsgDisburse{}, "treasury/Disburse", nil)
 	cdc.RegisterConcrete(MsgDisburseToEscrow{}, "treasury/DisburseToEscrow", nil)
 	cdc.RegisterConcrete(MsgDisburseFromEscrow{}, "treasury/DisburseFromEscrow", nil)
 	cdc.RegisterConcrete(MsgRevertFromEscrow{}, "treasury/RevertFromEscrow", nil)
 	cdc.RegisterConcrete(MsgCancelDisbursement{}, "treasury/CancelDisbursement", nil)
+	cdc.RegisterConcrete(MsgCancelDisbursementByReference{}, "treasury/CancelDisbursementByReference", nil)
+	cdc.RegisterConcrete(MsgCancelOperatorDisbursements{}, "treasury/CancelOperatorDisbursements", nil)
+	cdc.RegisterConcrete(MsgRetryDisbursement{}, "treasury/RetryDisbursement", nil)
+	cdc.RegisterConcrete(MsgAbandonDisbursement{}, "treasury/AbandonDisbursement", nil)
+	cdc.RegisterConcrete(MsgApproveDisbursement{}, "treasury/ApproveDisbursement", nil)
+	cdc.RegisterConcrete(MsgVetoDisbursement{}, "treasury/VetoDisbursement", nil)
 	cdc.RegisterConcrete(MsgCreateSellOrder{}, "treasury/CreateSellOrder", nil)
 	cdc.RegisterConcrete(MsgCreateBuyOrder{}, "treasury/CreateBuyOrder", nil)
+	cdc.RegisterConcrete(MsgCreateLimitOrder{}, "treasury/CreateLimitOrder", nil)
+	cdc.RegisterConcrete(MsgCancelLimitOrder{}, "treasury/CancelLimitOrder", nil)
 	cdc.RegisterConcrete(MsgSwap{}, "treasury/Swap", nil)
 
 	cdc.RegisterConcrete(AddBuyBackLiquidityProposal{}, "treasury/AddBuyBackLiquidityProposal", nil)
@@ -23,6 +32,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(TransferFromDistributionProfitsToBuyBackLiquidityProposal{}, "treasury/TransferFromDistributionProfitsToBuyBackLiquidityProposal", nil)
 	cdc.RegisterConcrete(TransferFromTreasuryToSwapEscrowProposal{}, "treasury/TransferFromTreasuryToSwapEscrowProposal", nil)
 	cdc.RegisterConcrete(TransferFromSwapEscrowToBuyBackProposal{}, "treasury/TransferFromSwapEscrowToBuyBackProposal", nil)
+	cdc.RegisterConcrete(SetPriceCurveProposal{}, "treasury/SetPriceCurveProposal", nil)
 }
 
 // ModuleCdc defines the module codec
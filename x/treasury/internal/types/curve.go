@@ -0,0 +1,201 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/DFWallet/anatha/types"
+	"strings"
+)
+
+const (
+	PriceCurveLinear      = "linear"
+	PriceCurveExponential = "exponential"
+	PriceCurvePiecewise   = "piecewise"
+)
+
+// MaxExponentialPriceCurveRate keeps exponential prices within sdk.Dec bounds over the whole treasury supply
+var MaxExponentialPriceCurveRate = sdk.NewDecWithPrec(1, 1)
+
+// PriceCurve returns the price of a stage counted from the stage the curve was activated at.
+// Prices are in units of $0.0000000001 per PIN and never drop below one.
+type PriceCurve interface {
+	PriceForStage(stage sdk.Int) sdk.Int
+}
+
+// LinearPriceCurve prices a stage at Base + Slope * stage
+type LinearPriceCurve struct {
+	Base 	sdk.Int
+	Slope 	sdk.Int
+}
+
+func (c LinearPriceCurve) PriceForStage(stage sdk.Int) sdk.Int {
+	return c.Base.Add(c.Slope.Mul(stage))
+}
+
+// ExponentialPriceCurve prices a stage at Base * (1 + Rate) ^ stage
+type ExponentialPriceCurve struct {
+	Base 	sdk.Int
+	Rate 	sdk.Dec
+}
+
+func (c ExponentialPriceCurve) PriceForStage(stage sdk.Int) sdk.Int {
+	return c.Base.ToDec().Mul(sdk.OneDec().Add(c.Rate).Power(stage.Uint64())).TruncateInt()
+}
+
+// PiecewisePriceCurve holds the price of the last step starting at or before the stage
+type PiecewisePriceCurve struct {
+	Steps []PriceCurveStep
+}
+
+func (c PiecewisePriceCurve) PriceForStage(stage sdk.Int) sdk.Int {
+	price := c.Steps[0].Price
+
+	for _, step := range c.Steps {
+		if sdk.NewIntFromUint64(step.FromStage).GT(stage) {
+			break
+		}
+
+		price = step.Price
+	}
+
+	return price
+}
+
+type PriceCurveStep struct {
+	FromStage 	uint64 	`json:"from_stage" yaml:"from_stage"`
+	Price 		sdk.Int `json:"price" yaml:"price"`
+}
+
+// PriceCurveParams is the stored form of a price curve, only the fields of the given type are used
+type PriceCurveParams struct {
+	Type 	string 				`json:"type" yaml:"type"`
+	Base 	sdk.Int 			`json:"base" yaml:"base"` // linear, exponential
+	Slope 	sdk.Int 			`json:"slope" yaml:"slope"` // linear
+	Rate 	sdk.Dec 			`json:"rate" yaml:"rate"` // exponential
+	Steps 	[]PriceCurveStep 	`json:"steps" yaml:"steps"` // piecewise
+}
+
+func NewLinearPriceCurveParams(base sdk.Int, slope sdk.Int) PriceCurveParams {
+	return PriceCurveParams{
+		Type: PriceCurveLinear,
+		Base: base,
+		Slope: slope,
+		Rate: sdk.ZeroDec(),
+		Steps: []PriceCurveStep{},
+	}
+}
+
+func NewExponentialPriceCurveParams(base sdk.Int, rate sdk.Dec) PriceCurveParams {
+	return PriceCurveParams{
+		Type: PriceCurveExponential,
+		Base: base,
+		Slope: sdk.ZeroInt(),
+		Rate: rate,
+		Steps: []PriceCurveStep{},
+	}
+}
+
+func NewPiecewisePriceCurveParams(steps []PriceCurveStep) PriceCurveParams {
+	return PriceCurveParams{
+		Type: PriceCurvePiecewise,
+		Base: sdk.ZeroInt(),
+		Slope: sdk.ZeroInt(),
+		Rate: sdk.ZeroDec(),
+		Steps: steps,
+	}
+}
+
+// DefaultPriceCurveParams prices stage n at n + 1, the curve the treasury launched with
+func DefaultPriceCurveParams() PriceCurveParams {
+	return NewLinearPriceCurveParams(sdk.OneInt(), sdk.OneInt())
+}
+
+func (p PriceCurveParams) Curve() PriceCurve {
+	switch p.Type {
+	case PriceCurveExponential:
+		return ExponentialPriceCurve{Base: p.Base, Rate: p.Rate}
+	case PriceCurvePiecewise:
+		return PiecewisePriceCurve{Steps: p.Steps}
+	default:
+		return LinearPriceCurve{Base: p.Base, Slope: p.Slope}
+	}
+}
+
+func (p PriceCurveParams) Validate() error {
+	switch p.Type {
+	case PriceCurveLinear:
+		if p.Base.IsNil() || ! p.Base.IsPositive() {
+			return fmt.Errorf("linear price curve base must be positive")
+		}
+		if p.Slope.IsNil() || p.Slope.IsNegative() {
+			return fmt.Errorf("linear price curve slope must not be negative")
+		}
+	case PriceCurveExponential:
+		if p.Base.IsNil() || ! p.Base.IsPositive() {
+			return fmt.Errorf("exponential price curve base must be positive")
+		}
+		if p.Rate.IsNil() || p.Rate.IsNegative() || p.Rate.GT(MaxExponentialPriceCurveRate) {
+			return fmt.Errorf("exponential price curve rate must be between 0 and %s", MaxExponentialPriceCurveRate)
+		}
+	case PriceCurvePiecewise:
+		if len(p.Steps) == 0 || p.Steps[0].FromStage != 0 {
+			return fmt.Errorf("piecewise price curve must start with a step from stage 0")
+		}
+		for i, step := range p.Steps {
+			if step.Price.IsNil() || ! step.Price.IsPositive() {
+				return fmt.Errorf("piecewise price curve prices must be positive")
+			}
+			if i > 0 && step.FromStage <= p.Steps[i - 1].FromStage {
+				return fmt.Errorf("piecewise price curve steps must be in increasing stage order")
+			}
+		}
+	default:
+		return fmt.Errorf("unknown price curve type: %s", p.Type)
+	}
+
+	return nil
+}
+
+func (p PriceCurveParams) String() string {
+	switch p.Type {
+	case PriceCurveExponential:
+		return fmt.Sprintf("exponential (base: %s, rate: %s)", p.Base, p.Rate)
+	case PriceCurvePiecewise:
+		var steps []string
+		for _, step := range p.Steps {
+			steps = append(steps, fmt.Sprintf("%d: %s", step.FromStage, step.Price))
+		}
+		return fmt.Sprintf("piecewise (%s)", strings.Join(steps, ", "))
+	default:
+		return fmt.Sprintf("linear (base: %s, slope: %s)", p.Base, p.Slope)
+	}
+}
+
+// ScheduledPriceCurve prices all stages from FromStage until the next scheduled curve
+type ScheduledPriceCurve struct {
+	FromStage 	uint64 				`json:"from_stage" yaml:"from_stage"`
+	Params 		PriceCurveParams 	`json:"params" yaml:"params"`
+}
+
+func NewScheduledPriceCurve(fromStage uint64, params PriceCurveParams) ScheduledPriceCurve {
+	return ScheduledPriceCurve{
+		FromStage: fromStage,
+		Params: params,
+	}
+}
+
+func DefaultScheduledPriceCurve() ScheduledPriceCurve {
+	return NewScheduledPriceCurve(0, DefaultPriceCurveParams())
+}
+
+func (c ScheduledPriceCurve) PriceForStage(stage sdk.Int) sdk.Int {
+	price := c.Params.Curve().PriceForStage(stage.Sub(sdk.NewIntFromUint64(c.FromStage)))
+	if price.LT(sdk.OneInt()) {
+		return sdk.OneInt()
+	}
+
+	return price
+}
+
+func (c ScheduledPriceCurve) String() string {
+	return fmt.Sprintf("from stage %d: %s", c.FromStage, c.Params)
+}
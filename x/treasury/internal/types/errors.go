@@ -14,4 +14,13 @@ var (
 	ErrDuplicateReference = sdkerrors.Register(ModuleName, 112, "Reference already used")
 	ErrEscrowRevertAmountTooBig = sdkerrors.Register(ModuleName, 113, "Escrow revert amount too big")
 	ErrDisbursementNotScheduled = sdkerrors.Register(ModuleName, 114, "Disbursement not scheduled")
+	ErrFailedDisbursementNotFound = sdkerrors.Register(ModuleName, 115, "Failed disbursement not found")
+	ErrPendingDisbursementNotFound = sdkerrors.Register(ModuleName, 116, "Pending disbursement not found")
+	ErrAlreadyApproved = sdkerrors.Register(ModuleName, 117, "Disbursement already approved by the manager")
+	ErrOperatorLimitExceeded = sdkerrors.Register(ModuleName, 118, "Operator spending limit exceeded")
+	ErrRecipientNotAllowed = sdkerrors.Register(ModuleName, 119, "Recipient not allowed for the operator")
+	ErrSlippageExceeded = sdkerrors.Register(ModuleName, 120, "Order would receive less than the minimum amount")
+	ErrLimitOrderNotFound = sdkerrors.Register(ModuleName, 121, "Limit order not found")
+	ErrNotOrderOwner = sdkerrors.Register(ModuleName, 122, "Not the owner of the limit order")
+	ErrInvalidPriceCurve = sdkerrors.Register(ModuleName, 123, "Invalid price curve")
 )
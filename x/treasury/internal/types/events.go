@@ -8,10 +8,20 @@ const (
 	EventTypeAddOperator 		= "add_operator"
 	EventTypeRemoveOperator 	= "remove_operator"
 	EventTypeCancelDisbursement	= "cancel_disbursement"
+	EventTypeCancelOperatorDisbursements = "cancel_operator_disbursements"
 	EventTypeCreateSellOrder	= "create_sell_order"
 	EventTypeCreateBuyOrder		= "create_buy_order"
+	EventTypeCreateLimitOrder	= "create_limit_order"
+	EventTypeCancelLimitOrder	= "cancel_limit_order"
+	EventTypeFillLimitOrder		= "fill_limit_order"
 	EventTypeTransfer			= "transfer_to_distribution_module"
 	EventTypeSwap				= "swap"
+	EventTypeDisbursementFailed	= "disbursement_failed"
+	EventTypeRetryDisbursement	= "retry_disbursement"
+	EventTypeAbandonDisbursement = "abandon_disbursement"
+	EventTypeApproveDisbursement = "approve_disbursement"
+	EventTypeVetoDisbursement	= "veto_disbursement"
+	EventTypeSetOperatorPolicy	= "set_operator_policy"
 
 	EventTypeAddBuyBackLiquidity = "AddBuyBackLiquidity"
 	EventTypeRemoveBuyBackLiquidity = "RemoveBuyBackLiquidity"
@@ -19,6 +29,7 @@ const (
 	EventTypeTransferFromDistributionProfitsToBuyBackLiquidity = "TransferFromDistributionProfitsToBuyBackLiquidity"
 	EventTypeTransferFromTreasuryToSwapEscrow = "TransferFromTreasuryToSwapEscrow"
 	EventTypeTransferSwapEscrowToBuyBack = "TransferSwapEscrowToBuyBack"
+	EventTypeSetPriceCurve = "SetPriceCurve"
 
 
 	AttributeKeySender				= "sender"
@@ -32,6 +43,21 @@ const (
 	AttributeKeyEscrowRemainder 	= "escrow_remainder"
 	AttributeKeyTitle					= "title"
 	AttributeKeyDescription				= "description"
+	AttributeKeyReason				= "reason"
+	AttributeKeyAttempts			= "attempts"
+	AttributeKeyNextRetry			= "next_retry"
+	AttributeKeyApprovals			= "approvals"
+	AttributeKeyRequiredApprovals	= "required_approvals"
+	AttributeKeyMaxPerTransaction	= "max_per_transaction"
+	AttributeKeyDailyQuota			= "daily_quota"
+	AttributeKeyWeeklyQuota			= "weekly_quota"
+	AttributeKeyCount				= "count"
+	AttributeKeyOrderId				= "order_id"
+	AttributeKeySide				= "side"
+	AttributeKeyPrice				= "price"
+	AttributeKeyOwner				= "owner"
+	AttributeKeyFromStage			= "from_stage"
+	AttributeKeyPriceCurve			= "price_curve"
 
 	AttributeValueModule = ModuleName
 )
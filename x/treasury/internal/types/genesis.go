@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	sdk "github.com/DFWallet/anatha/types"
 )
 
@@ -13,16 +14,41 @@ type GenesisState struct {
 	DisbursementQueue []Disbursement `json:"disbursement_queue" yaml:"disbursement_queue"`
 
 	DisbursementReferences []ReferenceAmountInfo `json:"disbursement_references" yaml:"disbursement_references"`
+
+	FailedDisbursements []FailedDisbursement `json:"failed_disbursements" yaml:"failed_disbursements"`
+
+	DisbursementRecords []DisbursementRecord `json:"disbursement_records" yaml:"disbursement_records"`
+
+	PendingDisbursements []PendingDisbursement `json:"pending_disbursements" yaml:"pending_disbursements"`
+
+	OperatorPolicies []OperatorPolicy `json:"operator_policies" yaml:"operator_policies"`
+	OperatorUsages []OperatorUsage `json:"operator_usages" yaml:"operator_usages"`
+
+	LimitOrders []LimitOrder `json:"limit_orders" yaml:"limit_orders"`
+	NextLimitOrderId uint64 `json:"next_limit_order_id" yaml:"next_limit_order_id"`
+
+	PriceCurves []ScheduledPriceCurve `json:"price_curves" yaml:"price_curves"`
+
+	Snapshots []TreasurySnapshot `json:"snapshots" yaml:"snapshots"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(treasury Treasury, params Params, operators []sdk.AccAddress, disbursements []Disbursement, references []ReferenceAmountInfo) GenesisState {
+func NewGenesisState(treasury Treasury, params Params, operators []sdk.AccAddress, disbursements []Disbursement, references []ReferenceAmountInfo, failedDisbursements []FailedDisbursement, records []DisbursementRecord, pendingDisbursements []PendingDisbursement, operatorPolicies []OperatorPolicy, operatorUsages []OperatorUsage, limitOrders []LimitOrder, nextLimitOrderId uint64, priceCurves []ScheduledPriceCurve, snapshots []TreasurySnapshot) GenesisState {
 	return GenesisState{
 		Treasury: 	treasury,
 		Params: 	params,
 		Operators: 	operators,
 		DisbursementQueue: disbursements,
 		DisbursementReferences: references,
+		FailedDisbursements: failedDisbursements,
+		DisbursementRecords: records,
+		PendingDisbursements: pendingDisbursements,
+		OperatorPolicies: operatorPolicies,
+		OperatorUsages: operatorUsages,
+		LimitOrders: limitOrders,
+		NextLimitOrderId: nextLimitOrderId,
+		PriceCurves: priceCurves,
+		Snapshots: snapshots,
 	}
 }
 
@@ -34,6 +60,15 @@ func DefaultGenesisState() GenesisState {
 		Operators: 	DefaultOperators(),
 		DisbursementQueue: []Disbursement{},
 		DisbursementReferences: []ReferenceAmountInfo{},
+		FailedDisbursements: []FailedDisbursement{},
+		DisbursementRecords: []DisbursementRecord{},
+		PendingDisbursements: []PendingDisbursement{},
+		OperatorPolicies: []OperatorPolicy{},
+		OperatorUsages: []OperatorUsage{},
+		LimitOrders: []LimitOrder{},
+		NextLimitOrderId: 1,
+		PriceCurves: []ScheduledPriceCurve{},
+		Snapshots: []TreasurySnapshot{},
 	}
 }
 
@@ -43,5 +78,20 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	for _, curve := range data.PriceCurves {
+		if err := curve.Params.Validate(); err != nil {
+			return fmt.Errorf("invalid price curve from stage %d: %s", curve.FromStage, err.Error())
+		}
+	}
+
+	for _, order := range data.LimitOrders {
+		if order.Id >= data.NextLimitOrderId {
+			return fmt.Errorf("limit order id %d is not below the next limit order id %d", order.Id, data.NextLimitOrderId)
+		}
+		if order.Price.IsNil() || ! order.Price.IsPositive() || ! order.Price.IsUint64() {
+			return fmt.Errorf("invalid price for limit order %d", order.Id)
+		}
+	}
+
 	return nil
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	sdk "github.com/DFWallet/anatha/types"
 	"time"
 )
@@ -13,6 +14,20 @@ var (
 
 	DisbursementReferenceKeyPrefix = []byte{0x15}
 
+	FailedDisbursementKeyPrefix        = []byte{0x16}
+	DisbursementRetryQueueKeyPrefix    = []byte{0x17}
+	DisbursementRecordKeyPrefix        = []byte{0x18}
+	PendingDisbursementKeyPrefix       = []byte{0x19}
+	OperatorPolicyKeyPrefix            = []byte{0x1A}
+	OperatorUsageKeyPrefix             = []byte{0x1B}
+	LimitOrderKeyPrefix                = []byte{0x1C}
+	LimitOrderBookKeyPrefix            = []byte{0x1D}
+	LimitOrderByOwnerKeyPrefix         = []byte{0x1E}
+	NextLimitOrderIdKey                = []byte{0x1F}
+	PriceCurveKeyPrefix                = []byte{0x20}
+	SnapshotKeyPrefix                  = []byte{0x21}
+	LimitOrderMatchCursorKeyPrefix     = []byte{0x22}
+
 	StatusPresent = []byte{0x01}
 )
 
@@ -36,6 +51,7 @@ const (
 	DistributionProfitsModuleName  	= "distribution_profits" 	// Module stores AST profits from Anatha purchases with AST
 	TreasuryEscrowModuleName        = "treasury_escrow"         // Module stores distributions without supplied recipient addresses
 	SwapEscrowModuleName			= "swap_escrow"             // Module stores ERC20 token balance
+	OrderBookModuleName				= "treasury_order_book"     // Module stores the funds of resting limit orders
 )
 
 
@@ -69,4 +85,86 @@ func GetDisbursementReferenceIteratorKey() []byte {
 
 func SplitDisbursementReferenceKey(key []byte) (string) {
 	return string(key[1:])
-}
\ No newline at end of file
+}
+
+func GetFailedDisbursementKey(reference string) []byte {
+	return append(FailedDisbursementKeyPrefix, []byte(reference)...)
+}
+
+func DisbursementRetryByTimeKey(retryAt time.Time) []byte {
+	return append(DisbursementRetryQueueKeyPrefix, sdk.FormatTimeBytes(retryAt)...)
+}
+
+func DisbursementRetryQueueKey(reference string, retryAt time.Time) []byte {
+	return append(DisbursementRetryByTimeKey(retryAt), []byte(reference)...)
+}
+
+func GetDisbursementRecordKey(reference string) []byte {
+	return append(DisbursementRecordKeyPrefix, []byte(reference)...)
+}
+
+func GetPendingDisbursementKey(reference string) []byte {
+	return append(PendingDisbursementKeyPrefix, []byte(reference)...)
+}
+
+func GetOperatorPolicyKey(operator sdk.AccAddress) []byte {
+	return append(OperatorPolicyKeyPrefix, operator...)
+}
+
+func GetOperatorUsageKey(operator sdk.AccAddress) []byte {
+	return append(OperatorUsageKeyPrefix, operator...)
+}
+
+func GetUint64Bytes(value uint64) (bz []byte) {
+	bz = make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, value)
+	return
+}
+
+func GetUint64FromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+func GetLimitOrderKey(id uint64) []byte {
+	return append(LimitOrderKeyPrefix, GetUint64Bytes(id)...)
+}
+
+func GetLimitOrderBookIteratorKey(side string) []byte {
+	sideByte := byte(0x01)
+	if side == OrderSideSell {
+		sideByte = 0x02
+	}
+
+	return append(LimitOrderBookKeyPrefix, sideByte)
+}
+
+// GetLimitOrderBookKey orders the book by best price and then by age, buy prices are inverted so the highest bid comes first
+func GetLimitOrderBookKey(order LimitOrder) []byte {
+	price := order.Price.Uint64()
+	if order.Side == OrderSideBuy {
+		price = ^price
+	}
+
+	key := append(GetLimitOrderBookIteratorKey(order.Side), GetUint64Bytes(price)...)
+	return append(key, GetUint64Bytes(order.Id)...)
+}
+
+func GetLimitOrderMatchCursorKey(side string) []byte {
+	return append(LimitOrderMatchCursorKeyPrefix, GetLimitOrderBookIteratorKey(side)[len(LimitOrderBookKeyPrefix):]...)
+}
+
+func GetLimitOrderByOwnerIteratorKey(owner sdk.AccAddress) []byte {
+	return append(LimitOrderByOwnerKeyPrefix, owner...)
+}
+
+func GetLimitOrderByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetLimitOrderByOwnerIteratorKey(owner), GetUint64Bytes(id)...)
+}
+
+func GetPriceCurveKey(fromStage uint64) []byte {
+	return append(PriceCurveKeyPrefix, GetUint64Bytes(fromStage)...)
+}
+
+func GetSnapshotKey(height int64) []byte {
+	return append(SnapshotKeyPrefix, GetUint64Bytes(uint64(height))...)
+}
@@ -77,6 +77,52 @@ func (msg MsgRemoveOperator) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
 
+// MsgSetOperatorPolicy
+type MsgSetOperatorPolicy struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Policy OperatorPolicy `json:"policy" yaml:"policy"`
+}
+
+func NewMsgSetOperatorPolicy(sender sdk.AccAddress, policy OperatorPolicy) MsgSetOperatorPolicy {
+	return MsgSetOperatorPolicy{
+		Sender: sender,
+		Policy: policy,
+	}
+}
+
+func (msg MsgSetOperatorPolicy) Route() string { return RouterKey }
+
+func (msg MsgSetOperatorPolicy) Type() string { return "set_operator_policy" }
+
+func (msg MsgSetOperatorPolicy) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender.String())
+	}
+	if msg.Policy.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Policy.Operator.String())
+	}
+	if ! msg.Policy.MaxPerTransaction.IsValid() || ! msg.Policy.DailyQuota.IsValid() || ! msg.Policy.WeeklyQuota.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid limit.")
+	}
+	if len(msg.Policy.AllowedRecipients) > MaxAllowedRecipients {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "At most %d allowed recipients", MaxAllowedRecipients)
+	}
+	for _, recipient := range msg.Policy.AllowedRecipients {
+		if recipient.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, recipient.String())
+		}
+	}
+	return nil
+}
+
+func (msg MsgSetOperatorPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetOperatorPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
 // MsgDisburse
 type MsgDisburse struct {
 	Operator  sdk.AccAddress `json:"operator" yaml:"operator"`
@@ -286,16 +332,228 @@ func (msg MsgCancelDisbursement) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Manager}
 }
 
+// MsgCancelDisbursementByReference
+type MsgCancelDisbursementByReference struct {
+	Manager sdk.AccAddress `json:"manager" yaml:"manager"`
+	Reference string `json:"reference" yaml:"reference"`
+}
+
+func NewMsgCancelDisbursementByReference(manager sdk.AccAddress, reference string) MsgCancelDisbursementByReference {
+	return MsgCancelDisbursementByReference{
+		Manager: manager,
+		Reference: reference,
+	}
+}
+
+func (msg MsgCancelDisbursementByReference) Route() string { return RouterKey }
+
+func (msg MsgCancelDisbursementByReference) Type() string { return "cancel_disbursement_by_reference" }
+
+func (msg MsgCancelDisbursementByReference) ValidateBasic() error {
+	if msg.Manager.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Manager.String())
+	}
+	if len(msg.Reference) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "Reference too long")
+	}
+	return nil
+}
+
+func (msg MsgCancelDisbursementByReference) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCancelDisbursementByReference) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Manager}
+}
+
+// MsgCancelOperatorDisbursements
+type MsgCancelOperatorDisbursements struct {
+	Manager sdk.AccAddress `json:"manager" yaml:"manager"`
+	Operator sdk.AccAddress `json:"operator" yaml:"operator"`
+}
+
+func NewMsgCancelOperatorDisbursements(manager sdk.AccAddress, operator sdk.AccAddress) MsgCancelOperatorDisbursements {
+	return MsgCancelOperatorDisbursements{
+		Manager: manager,
+		Operator: operator,
+	}
+}
+
+func (msg MsgCancelOperatorDisbursements) Route() string { return RouterKey }
+
+func (msg MsgCancelOperatorDisbursements) Type() string { return "cancel_operator_disbursements" }
+
+func (msg MsgCancelOperatorDisbursements) ValidateBasic() error {
+	if msg.Manager.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Manager.String())
+	}
+	if msg.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Operator.String())
+	}
+	return nil
+}
+
+func (msg MsgCancelOperatorDisbursements) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCancelOperatorDisbursements) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Manager}
+}
+
+// MsgRetryDisbursement
+type MsgRetryDisbursement struct {
+	Manager sdk.AccAddress `json:"manager" yaml:"manager"`
+	Reference string `json:"reference" yaml:"reference"`
+}
+
+func NewMsgRetryDisbursement(manager sdk.AccAddress, reference string) MsgRetryDisbursement {
+	return MsgRetryDisbursement{
+		Manager: manager,
+		Reference: reference,
+	}
+}
+
+func (msg MsgRetryDisbursement) Route() string { return RouterKey }
+
+func (msg MsgRetryDisbursement) Type() string { return "retry_disbursement" }
+
+func (msg MsgRetryDisbursement) ValidateBasic() error {
+	if msg.Manager.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Manager.String())
+	}
+	if len(msg.Reference) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "Reference too long")
+	}
+	return nil
+}
+
+func (msg MsgRetryDisbursement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRetryDisbursement) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Manager}
+}
+
+// MsgAbandonDisbursement
+type MsgAbandonDisbursement struct {
+	Manager sdk.AccAddress `json:"manager" yaml:"manager"`
+	Reference string `json:"reference" yaml:"reference"`
+}
+
+func NewMsgAbandonDisbursement(manager sdk.AccAddress, reference string) MsgAbandonDisbursement {
+	return MsgAbandonDisbursement{
+		Manager: manager,
+		Reference: reference,
+	}
+}
+
+func (msg MsgAbandonDisbursement) Route() string { return RouterKey }
+
+func (msg MsgAbandonDisbursement) Type() string { return "abandon_disbursement" }
+
+func (msg MsgAbandonDisbursement) ValidateBasic() error {
+	if msg.Manager.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Manager.String())
+	}
+	if len(msg.Reference) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "Reference too long")
+	}
+	return nil
+}
+
+func (msg MsgAbandonDisbursement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgAbandonDisbursement) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Manager}
+}
+
+// MsgApproveDisbursement
+type MsgApproveDisbursement struct {
+	Manager sdk.AccAddress `json:"manager" yaml:"manager"`
+	Reference string `json:"reference" yaml:"reference"`
+}
+
+func NewMsgApproveDisbursement(manager sdk.AccAddress, reference string) MsgApproveDisbursement {
+	return MsgApproveDisbursement{
+		Manager: manager,
+		Reference: reference,
+	}
+}
+
+func (msg MsgApproveDisbursement) Route() string { return RouterKey }
+
+func (msg MsgApproveDisbursement) Type() string { return "approve_disbursement" }
+
+func (msg MsgApproveDisbursement) ValidateBasic() error {
+	if msg.Manager.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Manager.String())
+	}
+	if len(msg.Reference) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "Reference too long")
+	}
+	return nil
+}
+
+func (msg MsgApproveDisbursement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgApproveDisbursement) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Manager}
+}
+
+// MsgVetoDisbursement
+type MsgVetoDisbursement struct {
+	Manager sdk.AccAddress `json:"manager" yaml:"manager"`
+	Reference string `json:"reference" yaml:"reference"`
+}
+
+func NewMsgVetoDisbursement(manager sdk.AccAddress, reference string) MsgVetoDisbursement {
+	return MsgVetoDisbursement{
+		Manager: manager,
+		Reference: reference,
+	}
+}
+
+func (msg MsgVetoDisbursement) Route() string { return RouterKey }
+
+func (msg MsgVetoDisbursement) Type() string { return "veto_disbursement" }
+
+func (msg MsgVetoDisbursement) ValidateBasic() error {
+	if msg.Manager.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Manager.String())
+	}
+	if len(msg.Reference) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "Reference too long")
+	}
+	return nil
+}
+
+func (msg MsgVetoDisbursement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgVetoDisbursement) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Manager}
+}
+
 // MsgCreateSellOrder
 type MsgCreateSellOrder struct {
 	Seller          sdk.AccAddress `json:"seller" yaml:"seller"`
 	Amount          sdk.Coins      `json:"amount" yaml:"amount"`
+	MinReceived     sdk.Coins      `json:"min_received" yaml:"min_received"`
 }
 
-func NewMsgCreateSellOrder(seller sdk.AccAddress, amount sdk.Coins) MsgCreateSellOrder {
+func NewMsgCreateSellOrder(seller sdk.AccAddress, amount sdk.Coins, minReceived sdk.Coins) MsgCreateSellOrder {
 	return MsgCreateSellOrder{
 		Seller:          seller,
 		Amount:          amount,
+		MinReceived:     minReceived,
 	}
 }
 
@@ -310,6 +568,9 @@ func (msg MsgCreateSellOrder) ValidateBasic() error {
 	if ! msg.Amount.AmountOf(config.DefaultDenom).IsPositive() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid amount.")
 	}
+	if ! msg.MinReceived.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid minimum received amount.")
+	}
 	return nil
 }
 
@@ -323,14 +584,16 @@ func (msg MsgCreateSellOrder) GetSigners() []sdk.AccAddress {
 
 // MsgCreateBuyOrder
 type MsgCreateBuyOrder struct {
-	Buyer 	sdk.AccAddress 	`json:"buyer" yaml:"buyer"`
-	Amount 	sdk.Coins 		`json:"amount" yaml:"amount"`
+	Buyer 		sdk.AccAddress 	`json:"buyer" yaml:"buyer"`
+	Amount 		sdk.Coins 		`json:"amount" yaml:"amount"`
+	MinReceived sdk.Coins 		`json:"min_received" yaml:"min_received"`
 }
 
-func NewMsgCreateBuyOrder(buyer sdk.AccAddress, amount sdk.Coins) MsgCreateBuyOrder {
+func NewMsgCreateBuyOrder(buyer sdk.AccAddress, amount sdk.Coins, minReceived sdk.Coins) MsgCreateBuyOrder {
 	return MsgCreateBuyOrder{
 		Buyer: buyer,
 		Amount: amount,
+		MinReceived: minReceived,
 	}
 }
 
@@ -345,6 +608,9 @@ func (msg MsgCreateBuyOrder) ValidateBasic() error {
 	if ! msg.Amount.AmountOf(config.DefaultStableDenom).IsPositive() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid amount.")
 	}
+	if ! msg.MinReceived.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid minimum received amount.")
+	}
 	return nil
 }
 
@@ -356,6 +622,94 @@ func (msg MsgCreateBuyOrder) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Buyer}
 }
 
+// MsgCreateLimitOrder
+type MsgCreateLimitOrder struct {
+	Owner 	sdk.AccAddress 	`json:"owner" yaml:"owner"`
+	Side 	string 			`json:"side" yaml:"side"`
+	Amount 	sdk.Coins 		`json:"amount" yaml:"amount"`
+	Price 	sdk.Int 		`json:"price" yaml:"price"`
+}
+
+func NewMsgCreateLimitOrder(owner sdk.AccAddress, side string, amount sdk.Coins, price sdk.Int) MsgCreateLimitOrder {
+	return MsgCreateLimitOrder{
+		Owner: owner,
+		Side: side,
+		Amount: amount,
+		Price: price,
+	}
+}
+
+func (msg MsgCreateLimitOrder) Route() string { return RouterKey }
+
+func (msg MsgCreateLimitOrder) Type() string { return "create_limit_order" }
+
+func (msg MsgCreateLimitOrder) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	if ! msg.Amount.IsValid() || len(msg.Amount) != 1 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid amount.")
+	}
+
+	switch msg.Side {
+	case OrderSideBuy:
+		if msg.Amount.AmountOf(config.DefaultStableDenom).LT(MinLimitOrderDinAmount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Amount must be at least %s%s.", MinLimitOrderDinAmount, config.DefaultStableDenom)
+		}
+	case OrderSideSell:
+		if msg.Amount.AmountOf(config.DefaultDenom).LT(MinLimitOrderPinAmount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Amount must be at least %s%s.", MinLimitOrderPinAmount, config.DefaultDenom)
+		}
+	default:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid order side.")
+	}
+
+	if msg.Price.IsNil() || ! msg.Price.IsPositive() || ! msg.Price.IsUint64() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid price.")
+	}
+	return nil
+}
+
+func (msg MsgCreateLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreateLimitOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgCancelLimitOrder
+type MsgCancelLimitOrder struct {
+	Owner 	sdk.AccAddress 	`json:"owner" yaml:"owner"`
+	Id 		uint64 			`json:"id" yaml:"id"`
+}
+
+func NewMsgCancelLimitOrder(owner sdk.AccAddress, id uint64) MsgCancelLimitOrder {
+	return MsgCancelLimitOrder{
+		Owner: owner,
+		Id: id,
+	}
+}
+
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+
+func (msg MsgCancelLimitOrder) Type() string { return "cancel_limit_order" }
+
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+	return nil
+}
+
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
 // MsgSwap
 type MsgSwap struct {
 	Operator  sdk.AccAddress `json:"operator" yaml:"operator"`
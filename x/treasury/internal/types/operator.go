@@ -1,7 +1,9 @@
 package types
 
 import (
+	"fmt"
 	sdk "github.com/DFWallet/anatha/types"
+	"time"
 )
 
 var (
@@ -21,4 +23,125 @@ func DefaultOperators() []sdk.AccAddress {
 	}
 
 	return operators
-}
\ No newline at end of file
+}
+
+const (
+	OperatorDailyWindow  = time.Hour * 24
+	OperatorWeeklyWindow = time.Hour * 24 * 7
+
+	MaxAllowedRecipients = 100
+)
+
+// OperatorPolicy limits the funds an operator can move, empty limits and an empty recipient list are not enforced
+type OperatorPolicy struct {
+	Operator 			sdk.AccAddress 		`json:"operator" yaml:"operator"`
+	MaxPerTransaction 	sdk.Coins 			`json:"max_per_transaction" yaml:"max_per_transaction"`
+	DailyQuota 			sdk.Coins 			`json:"daily_quota" yaml:"daily_quota"`
+	WeeklyQuota 		sdk.Coins 			`json:"weekly_quota" yaml:"weekly_quota"`
+	AllowedRecipients 	[]sdk.AccAddress 	`json:"allowed_recipients" yaml:"allowed_recipients"`
+}
+
+func NewOperatorPolicy(operator sdk.AccAddress, maxPerTransaction sdk.Coins, dailyQuota sdk.Coins, weeklyQuota sdk.Coins, allowedRecipients []sdk.AccAddress) OperatorPolicy {
+	return OperatorPolicy{
+		Operator: operator,
+		MaxPerTransaction: maxPerTransaction,
+		DailyQuota: dailyQuota,
+		WeeklyQuota: weeklyQuota,
+		AllowedRecipients: allowedRecipients,
+	}
+}
+
+func (p OperatorPolicy) IsEmpty() bool {
+	return p.MaxPerTransaction.Empty() && p.DailyQuota.Empty() && p.WeeklyQuota.Empty() && len(p.AllowedRecipients) == 0
+}
+
+func (p OperatorPolicy) IsRecipientAllowed(recipient sdk.AccAddress) bool {
+	if len(p.AllowedRecipients) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedRecipients {
+		if allowed.Equals(recipient) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p OperatorPolicy) String() string {
+	return fmt.Sprintf(`Operator: %s
+MaxPerTransaction: %s
+DailyQuota: %s
+WeeklyQuota: %s
+AllowedRecipients: %s`, p.Operator, p.MaxPerTransaction, p.DailyQuota, p.WeeklyQuota, p.AllowedRecipients)
+}
+
+type OperatorUsageEntry struct {
+	Time 		time.Time 	`json:"time" yaml:"time"`
+	Amount 		sdk.Coins 	`json:"amount" yaml:"amount"`
+	Reference 	string 		`json:"reference" yaml:"reference"`
+}
+
+// OperatorUsage keeps the spending of an operator within the weekly window
+type OperatorUsage struct {
+	Operator 	sdk.AccAddress 			`json:"operator" yaml:"operator"`
+	Entries 	[]OperatorUsageEntry 	`json:"entries" yaml:"entries"`
+}
+
+func NewOperatorUsage(operator sdk.AccAddress) OperatorUsage {
+	return OperatorUsage{
+		Operator: operator,
+		Entries: []OperatorUsageEntry{},
+	}
+}
+
+// Prune drops the entries that fell out of the weekly window
+func (u *OperatorUsage) Prune(now time.Time) {
+	entries := []OperatorUsageEntry{}
+
+	for _, entry := range u.Entries {
+		if now.Sub(entry.Time) < OperatorWeeklyWindow {
+			entries = append(entries, entry)
+		}
+	}
+
+	u.Entries = entries
+}
+
+// Refund drops the entries recorded for the reference
+func (u *OperatorUsage) Refund(reference string) {
+	entries := []OperatorUsageEntry{}
+
+	for _, entry := range u.Entries {
+		if entry.Reference != reference {
+			entries = append(entries, entry)
+		}
+	}
+
+	u.Entries = entries
+}
+
+// Spent returns the total spent within the window before now
+func (u OperatorUsage) Spent(now time.Time, window time.Duration) sdk.Coins {
+	spent := sdk.NewCoins()
+
+	for _, entry := range u.Entries {
+		if now.Sub(entry.Time) < window {
+			spent = spent.Add(entry.Amount...)
+		}
+	}
+
+	return spent
+}
+
+// ExceedsLimit tells whether the amount goes over the limit in any of the limited denoms
+func ExceedsLimit(amount sdk.Coins, limit sdk.Coins) bool {
+	for _, coin := range limit {
+		if amount.AmountOf(coin.Denom).GT(coin.Amount) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/DFWallet/anatha/types"
+	"github.com/DFWallet/project-anatha/config"
+	"time"
+)
+
+const (
+	OrderSideBuy  = "buy"
+	OrderSideSell = "sell"
+
+	// MaxLimitOrderFillsPerBlock bounds the fill attempts made on each side of the book in the EndBlocker
+	MaxLimitOrderFillsPerBlock = 100
+)
+
+var (
+	MinLimitOrderDinAmount = sdk.NewInt(10000000000) // $1
+	MinLimitOrderPinAmount = sdk.NewInt(100000000) // 1 anatha
+)
+
+// LimitOrder rests in the order book until the stage price crosses its limit price, the amount is escrowed in the order book module account
+type LimitOrder struct {
+	Id 			uint64 			`json:"id" yaml:"id"`
+	Owner 		sdk.AccAddress 	`json:"owner" yaml:"owner"`
+	Side 		string 			`json:"side" yaml:"side"`
+	Amount 		sdk.Coins 		`json:"amount" yaml:"amount"` // din for buy orders, pin for sell orders
+	Price 		sdk.Int 		`json:"price" yaml:"price"` // highest stage price for buy orders, lowest sell price for sell orders
+	CreatedAt 	time.Time 		`json:"created_at" yaml:"created_at"`
+}
+
+func NewLimitOrder(id uint64, owner sdk.AccAddress, side string, amount sdk.Coins, price sdk.Int, createdAt time.Time) LimitOrder {
+	return LimitOrder{
+		Id: id,
+		Owner: owner,
+		Side: side,
+		Amount: amount,
+		Price: price,
+		CreatedAt: createdAt,
+	}
+}
+
+// IsCrossed tells whether the order can be filled at the given prices
+func (o LimitOrder) IsCrossed(buyPrice sdk.Int, sellPrice sdk.Dec) bool {
+	if o.Side == OrderSideBuy {
+		return buyPrice.LTE(o.Price)
+	}
+
+	return sellPrice.GTE(o.Price.ToDec())
+}
+
+// MinReceived is the amount the order receives when filled exactly at its limit price
+func (o LimitOrder) MinReceived() sdk.Coins {
+	if o.Side == OrderSideBuy {
+		return sdk.NewCoins(sdk.NewCoin(config.DefaultDenom, o.Amount.AmountOf(config.DefaultStableDenom).Quo(o.Price)))
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(config.DefaultStableDenom, o.Amount.AmountOf(config.DefaultDenom).Mul(o.Price)))
+}
+
+func (o LimitOrder) String() string {
+	return fmt.Sprintf(`
+	Id: %d
+	Owner: %s
+	Side: %s
+	Amount: %s
+	Price: %s
+	CreatedAt: %s
+	`, o.Id, o.Owner, o.Side, o.Amount, o.Price, o.CreatedAt)
+}
@@ -13,6 +13,12 @@ const (
 
 	DefaultRiskAssesmentDuration 	= time.Hour * 24 * 3 // devnet: time.Second * 60 * 2
 	DefaultRiskAssesmentAmount		= 10000 // usd
+
+	DefaultDisbursementRetryBackoff	= time.Hour
+	DefaultDisbursementMaxRetries	= uint16(5)
+
+	DefaultSnapshotInterval			= int64(100) // blocks
+	DefaultSnapshotRetention		= time.Hour * 24 * 90
 )
 
 var (
@@ -20,12 +26,36 @@ var (
 	KeyRiskAssessmentAmount   = []byte("RiskAssesmentAmount")
 	KeyRiskAssessmentDuration = []byte("RiskAssesmentDuration")
 	KeyBuyBackPercentage      = []byte("BuyBackPercentage")
+	KeyDisbursementRetryBackoff = []byte("DisbursementRetryBackoff")
+	KeyDisbursementMaxRetries   = []byte("DisbursementMaxRetries")
+	KeyApprovalTiers            = []byte("ApprovalTiers")
+	KeySnapshotInterval         = []byte("SnapshotInterval")
+	KeySnapshotRetention        = []byte("SnapshotRetention")
 
 	DefaultManagerAddress = "anatha1qaf2gssp652s6np00a5cxdwytdf3vutdumwc0q"
 
 	DefaultBuyBackPercentage = sdk.NewDecWithPrec(24, 2)
+
+	DefaultApprovalTiers = []ApprovalTier{}
 )
 
+// ApprovalTier requires disbursements of at least Amount to be co-signed by Approvals managers before they are scheduled
+type ApprovalTier struct {
+	Amount 		sdk.Coins 	`json:"amount" yaml:"amount"`
+	Approvals 	uint16 		`json:"approvals" yaml:"approvals"`
+}
+
+func NewApprovalTier(amount sdk.Coins, approvals uint16) ApprovalTier {
+	return ApprovalTier{
+		Amount: amount,
+		Approvals: approvals,
+	}
+}
+
+func (t ApprovalTier) String() string {
+	return fmt.Sprintf("%s: %d", t.Amount, t.Approvals)
+}
+
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
 }
@@ -35,14 +65,24 @@ type Params struct {
 	RiskAssessmentAmount   sdk.Coins      `json:"risk_assesment_amount" yaml:"risk_assesment_amount"`
 	RiskAssessmentDuration time.Duration  `json:"risk_assesment_duration" yaml:"risk_assesment_duration"`
 	BuyBackPercentage      sdk.Dec        `json:"buyback_percentage" yaml:"buyback_percentage"`
+	DisbursementRetryBackoff time.Duration `json:"disbursement_retry_backoff" yaml:"disbursement_retry_backoff"` // delay before the first retry, doubled on every further attempt
+	DisbursementMaxRetries   uint16        `json:"disbursement_max_retries" yaml:"disbursement_max_retries"`
+	ApprovalTiers            []ApprovalTier `json:"approval_tiers" yaml:"approval_tiers"`
+	SnapshotInterval         int64          `json:"snapshot_interval" yaml:"snapshot_interval"` // blocks between treasury snapshots, 0 disables them
+	SnapshotRetention        time.Duration  `json:"snapshot_retention" yaml:"snapshot_retention"`
 }
 
-func NewParams(managers []sdk.AccAddress, amount sdk.Coins, riskAssessmentDuration time.Duration, buybackPercentage sdk.Dec) Params {
+func NewParams(managers []sdk.AccAddress, amount sdk.Coins, riskAssessmentDuration time.Duration, buybackPercentage sdk.Dec, retryBackoff time.Duration, maxRetries uint16, approvalTiers []ApprovalTier, snapshotInterval int64, snapshotRetention time.Duration) Params {
 	return Params{
 		Managers:               managers,
 		RiskAssessmentAmount:   amount,
 		RiskAssessmentDuration: riskAssessmentDuration,
 		BuyBackPercentage:      buybackPercentage,
+		DisbursementRetryBackoff: retryBackoff,
+		DisbursementMaxRetries:   maxRetries,
+		ApprovalTiers:            approvalTiers,
+		SnapshotInterval:         snapshotInterval,
+		SnapshotRetention:        snapshotRetention,
 	}
 }
 
@@ -51,7 +91,12 @@ func (p Params) String() string {
 	Managers: %s
 	RiskAssesmentAmount: %s
 	RiskAssesmentDuration: %s
-	`, p.Managers, p.RiskAssessmentAmount, p.RiskAssessmentDuration)
+	DisbursementRetryBackoff: %s
+	DisbursementMaxRetries: %d
+	ApprovalTiers: %s
+	SnapshotInterval: %d
+	SnapshotRetention: %s
+	`, p.Managers, p.RiskAssessmentAmount, p.RiskAssessmentDuration, p.DisbursementRetryBackoff, p.DisbursementMaxRetries, p.ApprovalTiers, p.SnapshotInterval, p.SnapshotRetention)
 }
 
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
@@ -60,6 +105,11 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyRiskAssessmentAmount, &p.RiskAssessmentAmount, validateCoins),
 		params.NewParamSetPair(KeyRiskAssessmentDuration, &p.RiskAssessmentDuration, validateDuration),
 		params.NewParamSetPair(KeyBuyBackPercentage, &p.BuyBackPercentage, validateBuyBackPercentage),
+		params.NewParamSetPair(KeyDisbursementRetryBackoff, &p.DisbursementRetryBackoff, validateDuration),
+		params.NewParamSetPair(KeyDisbursementMaxRetries, &p.DisbursementMaxRetries, validateMaxRetries),
+		params.NewParamSetPair(KeyApprovalTiers, &p.ApprovalTiers, validateApprovalTiers),
+		params.NewParamSetPair(KeySnapshotInterval, &p.SnapshotInterval, validateSnapshotInterval),
+		params.NewParamSetPair(KeySnapshotRetention, &p.SnapshotRetention, validateDuration),
 	}
 }
 
@@ -72,6 +122,11 @@ func DefaultParams() Params {
 		sdk.NewCoins(amount),
 		DefaultRiskAssesmentDuration,
 		DefaultBuyBackPercentage,
+		DefaultDisbursementRetryBackoff,
+		DefaultDisbursementMaxRetries,
+		DefaultApprovalTiers,
+		DefaultSnapshotInterval,
+		DefaultSnapshotRetention,
 	)
 }
 
@@ -92,6 +147,32 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateDuration(p.DisbursementRetryBackoff); err != nil {
+		return err
+	}
+
+	if err := validateMaxRetries(p.DisbursementMaxRetries); err != nil {
+		return err
+	}
+
+	if err := validateApprovalTiers(p.ApprovalTiers); err != nil {
+		return err
+	}
+
+	if err := validateSnapshotInterval(p.SnapshotInterval); err != nil {
+		return err
+	}
+
+	if err := validateDuration(p.SnapshotRetention); err != nil {
+		return err
+	}
+
+	for _, tier := range p.ApprovalTiers {
+		if int(tier.Approvals) > len(p.Managers) {
+			return fmt.Errorf("approval tier %s requires more approvals than there are managers", tier)
+		}
+	}
+
 	return nil
 }
 
@@ -146,4 +227,49 @@ func validateBuyBackPercentage(i interface{}) error {
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+func validateMaxRetries(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > 16 {
+		return fmt.Errorf("at most 16 disbursement retries can be configured: %d", v)
+	}
+
+	return nil
+}
+
+func validateApprovalTiers(i interface{}) error {
+	v, ok := i.([]ApprovalTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, tier := range v {
+		if !tier.Amount.IsValid() || tier.Amount.Empty() {
+			return fmt.Errorf("invalid approval tier amount: %s", tier.Amount)
+		}
+
+		if tier.Approvals == 0 {
+			return fmt.Errorf("approval tier must require at least one approval: %d", tier.Approvals)
+		}
+	}
+
+	return nil
+}
+
+func validateSnapshotInterval(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("snapshot interval must not be negative: %d", v)
+	}
+
+	return nil
+}
@@ -15,6 +15,7 @@ const (
 	ProposalTypeTransferFromDistributionProfitsToBuyBackLiquidity = "TransferFromDistributionProfitsToBuyBackLiquidity"
 	ProposalTypeTransferFromTreasuryToSwapEscrow = "TransferFromTreasuryToSwapEscrow"
 	ProposalTypeTransferSwapEscrowToBuyBack = "TransferSwapEscrowToBuyBack"
+	ProposalTypeSetPriceCurve = "SetPriceCurve"
 )
 
 func init() {
@@ -30,6 +31,8 @@ func init() {
 	gov.RegisterProposalTypeCodec(TransferFromTreasuryToSwapEscrowProposal{}, "treasury/TransferFromTreasuryToSwapEscrowProposal")
 	gov.RegisterProposalType(ProposalTypeTransferSwapEscrowToBuyBack)
 	gov.RegisterProposalTypeCodec(TransferFromSwapEscrowToBuyBackProposal{}, "treasury/TransferFromSwapEscrowToBuyBackProposal")
+	gov.RegisterProposalType(ProposalTypeSetPriceCurve)
+	gov.RegisterProposalTypeCodec(SetPriceCurveProposal{}, "treasury/SetPriceCurveProposal")
 }
 
 var _ gov.Content = AddBuyBackLiquidityProposal{}
@@ -222,4 +225,39 @@ func (p TransferFromSwapEscrowToBuyBackProposal) String() string {
   Description: 	%s
   Amount: 		%s
 `, p.Title, p.Description, p.Amount)
-}
\ No newline at end of file
+}
+
+var _ gov.Content = SetPriceCurveProposal{}
+
+// SetPriceCurveProposal prices all stages from FromStage with the given curve
+type SetPriceCurveProposal struct {
+	Title       string 				`json:"title" yaml:"title"`
+	Description string 				`json:"description" yaml:"description"`
+	FromStage 	uint64 				`json:"from_stage" yaml:"from_stage"`
+	Curve 		PriceCurveParams 	`json:"curve" yaml:"curve"`
+}
+
+func NewSetPriceCurveProposal(title, description string, fromStage uint64, curve PriceCurveParams) gov.Content {
+	return SetPriceCurveProposal{title, description, fromStage, curve}
+}
+
+func (p SetPriceCurveProposal) GetTitle() string       { return p.Title }
+func (p SetPriceCurveProposal) GetDescription() string { return p.Description }
+func (p SetPriceCurveProposal) ProposalRoute() string  { return RouterKey }
+func (p SetPriceCurveProposal) ProposalType() string   { return ProposalTypeSetPriceCurve }
+func (p SetPriceCurveProposal) ValidateBasic() error {
+	if err := p.Curve.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPriceCurve, err.Error())
+	}
+
+	return gov.ValidateAbstract(p)
+}
+
+func (p SetPriceCurveProposal) String() string {
+	return fmt.Sprintf(`Set Price Curve Proposal:
+  Title: 		%s
+  Description: 	%s
+  From Stage: 	%d
+  Curve: 		%s
+`, p.Title, p.Description, p.FromStage, p.Curve)
+}
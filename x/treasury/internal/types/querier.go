@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	sdk "github.com/DFWallet/anatha/types"
 	"strings"
 )
@@ -29,8 +30,70 @@ func (n QueryResDisbursements) String() string {
 	return strings.Join(disbursements, "\n")
 }
 
+type QueryResFailedDisbursements []FailedDisbursement
+
+func (n QueryResFailedDisbursements) String() string {
+	var failedDisbursements []string
+
+	for _, failed := range n {
+		failedDisbursements = append(failedDisbursements, failed.String())
+	}
+
+	return strings.Join(failedDisbursements, "\n")
+}
+
+type QueryResPendingDisbursements []PendingDisbursement
+
+func (n QueryResPendingDisbursements) String() string {
+	var pendingDisbursements []string
+
+	for _, pending := range n {
+		pendingDisbursements = append(pendingDisbursements, pending.String())
+	}
+
+	return strings.Join(pendingDisbursements, "\n")
+}
+
+type QueryResOperator struct {
+	Operator 	sdk.AccAddress 	`json:"operator" yaml:"operator"`
+	Active 		bool 			`json:"active" yaml:"active"`
+	Policy 		OperatorPolicy 	`json:"policy" yaml:"policy"`
+	DailyUsage 	sdk.Coins 		`json:"daily_usage" yaml:"daily_usage"`
+	WeeklyUsage sdk.Coins 		`json:"weekly_usage" yaml:"weekly_usage"`
+}
+
+func (n QueryResOperator) String() string {
+	return fmt.Sprintf(`Operator: %s
+Active: %t
+DailyUsage: %s
+WeeklyUsage: %s
+Policy:
+%s`, n.Operator, n.Active, n.DailyUsage, n.WeeklyUsage, n.Policy)
+}
+
 type QueryResPrice sdk.Coins
 
 func (n QueryResPrice) String() string {
 	return n.String()
-}
\ No newline at end of file
+}
+
+type QueryResPriceCurve struct {
+	Stage 		sdk.Int 				`json:"stage" yaml:"stage"`
+	Price 		sdk.Int 				`json:"price" yaml:"price"`
+	Active 		ScheduledPriceCurve 	`json:"active" yaml:"active"`
+	Scheduled 	[]ScheduledPriceCurve 	`json:"scheduled" yaml:"scheduled"`
+}
+
+func (n QueryResPriceCurve) String() string {
+	var scheduled []string
+
+	for _, curve := range n.Scheduled {
+		scheduled = append(scheduled, curve.String())
+	}
+
+	return fmt.Sprintf(`Stage: %s
+Price: %s
+Active: %s
+Scheduled:
+%s`, n.Stage, n.Price, n.Active, strings.Join(scheduled, "\n"))
+}
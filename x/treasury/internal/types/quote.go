@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/DFWallet/anatha/types"
+	"strings"
+)
+
+const (
+	QuoteSourceBuyBack  = "buyback"
+	QuoteSourceTreasury = "treasury"
+)
+
+// QuoteStage is the part of a trade filled at a single stage price
+type QuoteStage struct {
+	Source 		string 	`json:"source" yaml:"source"`
+	Stage 		sdk.Int `json:"stage" yaml:"stage"`
+	Price 		sdk.Int `json:"price" yaml:"price"`
+	PinAmount 	sdk.Int `json:"pin_amount" yaml:"pin_amount"`
+	DinAmount 	sdk.Int `json:"din_amount" yaml:"din_amount"`
+}
+
+func (s QuoteStage) String() string {
+	return fmt.Sprintf("%s stage %s at %s: %spin for %sdin", s.Source, s.Stage, s.Price, s.PinAmount, s.DinAmount)
+}
+
+// Quote simulates a buy or sell order against the current state without executing it
+type Quote struct {
+	Side 				string 			`json:"side" yaml:"side"`
+	Input 				sdk.Coins 		`json:"input" yaml:"input"`
+	Output 				sdk.Coins 		`json:"output" yaml:"output"`
+	FromBuyBack 		sdk.Coins 		`json:"from_buyback" yaml:"from_buyback"`
+	FromTreasury 		sdk.Coins 		`json:"from_treasury" yaml:"from_treasury"`
+	BuyBackPercentage 	sdk.Dec 		`json:"buyback_percentage" yaml:"buyback_percentage"`
+	Haircut 			sdk.Coins 		`json:"haircut" yaml:"haircut"` // din withheld from sellers by the buyback percentage
+	StartStage 			sdk.Int 		`json:"start_stage" yaml:"start_stage"`
+	EndStage 			sdk.Int 		`json:"end_stage" yaml:"end_stage"`
+	Stages 				[]QuoteStage 	`json:"stages" yaml:"stages"`
+	AveragePrice 		sdk.Dec 		`json:"average_price" yaml:"average_price"` // din per pin
+}
+
+func (q Quote) String() string {
+	var stages []string
+
+	for _, stage := range q.Stages {
+		stages = append(stages, "  " + stage.String())
+	}
+
+	return fmt.Sprintf(`Side: %s
+Input: %s
+Output: %s
+FromBuyBack: %s
+FromTreasury: %s
+BuyBackPercentage: %s
+Haircut: %s
+StartStage: %s
+EndStage: %s
+AveragePrice: %s
+Stages:
+%s`, q.Side, q.Input, q.Output, q.FromBuyBack, q.FromTreasury, q.BuyBackPercentage, q.Haircut, q.StartStage, q.EndStage, q.AveragePrice, strings.Join(stages, "\n"))
+}
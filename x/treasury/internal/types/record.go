@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/DFWallet/anatha/types"
+	"strings"
+	"time"
+)
+
+const (
+	DisbursementStatusPendingApproval = "pending_approval"
+	DisbursementStatusVetoed = "vetoed"
+	DisbursementStatusScheduled = "scheduled"
+	DisbursementStatusPaid = "paid"
+	DisbursementStatusEscrowed = "escrowed"
+	DisbursementStatusPartiallyReverted = "partially_reverted"
+	DisbursementStatusReverted = "reverted"
+	DisbursementStatusCancelled = "cancelled"
+	DisbursementStatusFailed = "failed"
+	DisbursementStatusAbandoned = "abandoned"
+	DisbursementStatusSwapped = "swapped"
+)
+
+// DisbursementRecordEntry is a single state change of a disbursement
+type DisbursementRecordEntry struct {
+	Status 	string 		`json:"status" yaml:"status"`
+	Amount 	sdk.Coins 	`json:"amount" yaml:"amount"`
+	Height 	int64 		`json:"height" yaml:"height"`
+	Time 	time.Time 	`json:"time" yaml:"time"`
+	Note 	string 		`json:"note" yaml:"note"`
+}
+
+func (e DisbursementRecordEntry) String() string {
+	return fmt.Sprintf(`	%s at height %d (%s): %s %s`, e.Status, e.Height, e.Time, e.Amount, e.Note)
+}
+
+// DisbursementRecord is the audit trail of everything that happened to a disbursement reference
+type DisbursementRecord struct {
+	Reference 		string 						`json:"reference" yaml:"reference"`
+	Operator 		sdk.AccAddress 				`json:"operator" yaml:"operator"`
+	Recipient 		sdk.AccAddress 				`json:"recipient" yaml:"recipient"`
+	Amount 			sdk.Coins 					`json:"amount" yaml:"amount"`
+	Status 			string 						`json:"status" yaml:"status"`
+	CreatedHeight 	int64 						`json:"created_height" yaml:"created_height"`
+	UpdatedHeight 	int64 						`json:"updated_height" yaml:"updated_height"`
+	History 		[]DisbursementRecordEntry 	`json:"history" yaml:"history"`
+}
+
+func NewDisbursementRecord(reference string, operator sdk.AccAddress, recipient sdk.AccAddress, amount sdk.Coins, height int64) DisbursementRecord {
+	return DisbursementRecord{
+		Reference: reference,
+		Operator: operator,
+		Recipient: recipient,
+		Amount: amount,
+		CreatedHeight: height,
+		UpdatedHeight: height,
+		History: []DisbursementRecordEntry{},
+	}
+}
+
+// AddEntry moves the record to the status and appends the change to the history
+func (r *DisbursementRecord) AddEntry(status string, amount sdk.Coins, height int64, t time.Time, note string) {
+	r.Status = status
+	r.UpdatedHeight = height
+	r.History = append(r.History, DisbursementRecordEntry{
+		Status: status,
+		Amount: amount,
+		Height: height,
+		Time: t,
+		Note: note,
+	})
+}
+
+func (r DisbursementRecord) String() string {
+	var history []string
+
+	for _, entry := range r.History {
+		history = append(history, entry.String())
+	}
+
+	return fmt.Sprintf(`Reference: %s
+Operator: %s
+Recipient: %s
+Amount: %s
+Status: %s
+CreatedHeight: %d
+UpdatedHeight: %d
+History:
+%s`, r.Reference, r.Operator, r.Recipient, r.Amount, r.Status, r.CreatedHeight, r.UpdatedHeight, strings.Join(history, "\n"))
+}
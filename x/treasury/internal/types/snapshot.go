@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/DFWallet/anatha/types"
+	"strings"
+	"time"
+)
+
+// MaxSnapshotQueryResults bounds the number of snapshots returned by a single range query
+const MaxSnapshotQueryResults = 1000
+
+// TreasurySnapshot records the treasury state at the end of a block
+type TreasurySnapshot struct {
+	Height 				int64 		`json:"height" yaml:"height"`
+	Time 				time.Time 	`json:"time" yaml:"time"`
+	Distributed 		sdk.Coins 	`json:"distributed" yaml:"distributed"`
+	InboundDin 			sdk.Coins 	`json:"inbound_din" yaml:"inbound_din"`
+	Stage 				sdk.Int 	`json:"stage" yaml:"stage"`
+	StagePrice 			sdk.Int 	`json:"stage_price" yaml:"stage_price"`
+	BuyBackFund 		sdk.Coins 	`json:"buyback_fund" yaml:"buyback_fund"`
+	BuyBackLiquidity 	sdk.Coins 	`json:"buyback_liquidity" yaml:"buyback_liquidity"`
+	TreasuryEscrow 		sdk.Coins 	`json:"treasury_escrow" yaml:"treasury_escrow"`
+	SwapEscrow 			sdk.Coins 	`json:"swap_escrow" yaml:"swap_escrow"`
+}
+
+func (s TreasurySnapshot) String() string {
+	return fmt.Sprintf(`
+	Height: %d
+	Time: %s
+	Distributed: %s
+	InboundDin: %s
+	Stage: %s
+	StagePrice: %s
+	BuyBackFund: %s
+	BuyBackLiquidity: %s
+	TreasuryEscrow: %s
+	SwapEscrow: %s
+	`, s.Height, s.Time, s.Distributed, s.InboundDin, s.Stage, s.StagePrice, s.BuyBackFund, s.BuyBackLiquidity, s.TreasuryEscrow, s.SwapEscrow)
+}
+
+// QuerySnapshotsParams selects snapshots by height and time, zero values leave the bound open
+type QuerySnapshotsParams struct {
+	FromHeight 	int64 		`json:"from_height" yaml:"from_height"`
+	ToHeight 	int64 		`json:"to_height" yaml:"to_height"`
+	FromTime 	time.Time 	`json:"from_time" yaml:"from_time"`
+	ToTime 		time.Time 	`json:"to_time" yaml:"to_time"`
+	Limit 		int 		`json:"limit" yaml:"limit"`
+}
+
+func NewQuerySnapshotsParams(fromHeight int64, toHeight int64, fromTime time.Time, toTime time.Time, limit int) QuerySnapshotsParams {
+	return QuerySnapshotsParams{
+		FromHeight: fromHeight,
+		ToHeight: toHeight,
+		FromTime: fromTime,
+		ToTime: toTime,
+		Limit: limit,
+	}
+}
+
+type QueryResSnapshots []TreasurySnapshot
+
+func (n QueryResSnapshots) String() string {
+	var snapshots []string
+
+	for _, snapshot := range n {
+		snapshots = append(snapshots, snapshot.String())
+	}
+
+	return strings.Join(snapshots, "\n")
+}
@@ -46,4 +46,72 @@ func NewReferenceAmountInfo(reference string, amount sdk.Int) ReferenceAmountInf
 func (a ReferenceAmountInfo) String() string {
 	return fmt.Sprintf(`Reference: %s
 Amount: %s`, a.Reference, a.Amount)
-}
\ No newline at end of file
+}
+
+// FailedDisbursement keeps a scheduled disbursement that could not be paid out until it is retried successfully or abandoned
+type FailedDisbursement struct {
+	Disbursement 	Disbursement 	`json:"disbursement" yaml:"disbursement"`
+	Reason 			string 			`json:"reason" yaml:"reason"`
+	Attempts 		uint16 			`json:"attempts" yaml:"attempts"`
+	FailedAt 		time.Time 		`json:"failed_at" yaml:"failed_at"`
+	NextRetry 		time.Time 		`json:"next_retry" yaml:"next_retry"` // zero when automatic retries are exhausted
+}
+
+func NewFailedDisbursement(disbursement Disbursement, reason string, attempts uint16, failedAt time.Time, nextRetry time.Time) FailedDisbursement {
+	return FailedDisbursement{
+		Disbursement: disbursement,
+		Reason: reason,
+		Attempts: attempts,
+		FailedAt: failedAt,
+		NextRetry: nextRetry,
+	}
+}
+
+func (f FailedDisbursement) HasRetryScheduled() bool {
+	return ! f.NextRetry.IsZero()
+}
+
+func (f FailedDisbursement) String() string {
+	return fmt.Sprintf(`%s
+	Reason: %s
+	Attempts: %d
+	FailedAt: %s
+	NextRetry: %s
+	`, f.Disbursement, f.Reason, f.Attempts, f.FailedAt, f.NextRetry)
+}
+
+// PendingDisbursement is a disbursement waiting for manager approvals before it enters the disbursement queue
+type PendingDisbursement struct {
+	Disbursement 		Disbursement 		`json:"disbursement" yaml:"disbursement"`
+	RequiredApprovals 	uint16 				`json:"required_approvals" yaml:"required_approvals"`
+	Approvals 			[]sdk.AccAddress 	`json:"approvals" yaml:"approvals"`
+}
+
+func NewPendingDisbursement(disbursement Disbursement, requiredApprovals uint16) PendingDisbursement {
+	return PendingDisbursement{
+		Disbursement: disbursement,
+		RequiredApprovals: requiredApprovals,
+		Approvals: []sdk.AccAddress{},
+	}
+}
+
+func (p PendingDisbursement) HasApproved(manager sdk.AccAddress) bool {
+	for _, approval := range p.Approvals {
+		if approval.Equals(manager) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p PendingDisbursement) IsApproved() bool {
+	return len(p.Approvals) >= int(p.RequiredApprovals)
+}
+
+func (p PendingDisbursement) String() string {
+	return fmt.Sprintf(`%s
+	RequiredApprovals: %d
+	Approvals: %s
+	`, p.Disbursement, p.RequiredApprovals, p.Approvals)
+}